@@ -17,6 +17,8 @@ import (
 	"yourproject/internal/api/middlewares"
 	"yourproject/internal/api/routes"
 	"yourproject/internal/config"
+	"yourproject/internal/services/campaign"
+	"yourproject/internal/services/export"
 	"yourproject/internal/services/rabbitmq"
 	"yourproject/internal/services/rabbitmq/consumers"
 	"yourproject/internal/services/webhook"
@@ -47,6 +49,9 @@ func main() {
 
 	// Initialize session manager
 	sessionManager := whatsapp.NewSessionManager(sqlStore)
+	sessionManager.SetNumberCacheTTL(cfg.NumberCachePositiveTTL, cfg.NumberCacheNegativeTTL)
+	sessionManager.SetGroupCacheSyncInterval(cfg.GroupCacheSyncInterval)
+	sessionManager.SetProfileCacheTTL(cfg.ProfileCacheTTL)
 
 	// Initialize RabbitMQ publisher if configured
 	var eventPublisher *rabbitmq.EventPublisher
@@ -142,6 +147,22 @@ func main() {
 		}
 	}
 
+	// Initialize campaign service and resume campaigns interrupted by a restart
+	campaignService := campaign.NewService(sqlStore, sessionManager, eventPublisher)
+	if err := campaignService.RestoreRunning(); err != nil {
+		logger.Error("Falha ao retomar campanhas", "error", err)
+	}
+
+	// Initialize export service, storing files next to the database unless configured
+	exportDir := filepath.Join(filepath.Dir(dbPath), "exports")
+	if cfg.ExportDir != "" {
+		exportDir = cfg.ExportDir
+	}
+	exportService, err := export.NewService(sqlStore, sessionManager, exportDir)
+	if err != nil {
+		log.Fatalf("Failed to initialize export service: %v", err)
+	}
+
 	// Start periodic cleanup for inactive sessions (every 30 minutes, remove sessions inactive for 24 hours)
 	sessionManager.StartPeriodicCleanup(30*time.Minute, 24*time.Hour)
 
@@ -172,6 +193,21 @@ func main() {
 	sessionManager.RegisterEventHandler("qr", func(userID string, evt interface{}) error {
 		return webhookService.DispatchEvent(userID, "qr", evt)
 	})
+	for _, callEvent := range []string{"call.offer", "call.accepted", "call.rejected", "call.terminated", "call.auto_rejected"} {
+		sessionManager.RegisterEventHandler(callEvent, func(userID string, evt interface{}) error {
+			return webhookService.DispatchEvent(userID, callEvent, evt)
+		})
+	}
+	for _, labelEvent := range []string{"label.updated", "label.deleted", "label.chat.updated", "label.message.updated"} {
+		sessionManager.RegisterEventHandler(labelEvent, func(userID string, evt interface{}) error {
+			return webhookService.DispatchEvent(userID, labelEvent, evt)
+		})
+	}
+	for _, statusEvent := range []string{"status.posted", "status.media"} {
+		sessionManager.RegisterEventHandler(statusEvent, func(userID string, evt interface{}) error {
+			return webhookService.DispatchEvent(userID, statusEvent, evt)
+		})
+	}
 
 	// Configure HTTP handlers
 	sessionHandler := handlers.NewSessionHandler(sessionManager, cfg)
@@ -179,6 +215,17 @@ func main() {
 	groupHandler := handlers.NewGroupHandler(sessionManager)
 	communityHandler := handlers.NewCommunityHandler(sessionManager)
 	newsletterHandler := handlers.NewNewsletterHandler(sessionManager)
+	campaignHandler := handlers.NewCampaignHandler(campaignService)
+	suppressionHandler := handlers.NewSuppressionHandler(sessionManager)
+	exportHandler := handlers.NewExportHandler(sessionManager, exportService)
+	chatHandler := handlers.NewChatHandler(sessionManager)
+	profileHandler := handlers.NewProfileHandler(sessionManager)
+	blocklistHandler := handlers.NewBlocklistHandler(sessionManager)
+	callHandler := handlers.NewCallHandler(sessionManager)
+	historyHandler := handlers.NewHistoryHandler(sessionManager)
+	contactHandler := handlers.NewContactHandler(sessionManager)
+	labelHandler := handlers.NewLabelHandler(sessionManager)
+	statusHandler := handlers.NewStatusHandler(sessionManager)
 	webhookHandler := handlers.NewWebhookHandler(webhookService)
 	authHandler := handlers.NewAuthHandler(cfg.EncryptionKey)
 
@@ -187,7 +234,7 @@ func main() {
 
 	// Configure HTTP server
 	r := gin.Default()
-	routes.SetupRoutes(r, sessionHandler, messageHandler, webhookHandler, groupHandler, newsletterHandler, communityHandler, authHandler, authMiddleware)
+	routes.SetupRoutes(r, sessionHandler, messageHandler, webhookHandler, groupHandler, newsletterHandler, communityHandler, campaignHandler, suppressionHandler, exportHandler, chatHandler, profileHandler, blocklistHandler, callHandler, historyHandler, contactHandler, labelHandler, statusHandler, authHandler, authMiddleware)
 
 	// Start server with graceful shutdown
 	srv := &http.Server{
@@ -210,6 +257,9 @@ func main() {
 
 	log.Println("Shutting down server...")
 
+	// Stop campaign runners (progress is persisted and resumed on next start)
+	campaignService.Stop()
+
 	// Stop coordinator system
 	logger.Info("Parando sistema de coordenação...")
 	if err := sessionManager.StopCoordinator(); err != nil {
@@ -6,7 +6,7 @@ require (
 	github.com/gin-gonic/gin v1.10.0
 	github.com/mattn/go-sqlite3 v1.14.24
 	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
-	go.mau.fi/whatsmeow v0.0.0-20250514120708-22ca98ea604a
+	go.mau.fi/whatsmeow v0.0.0-20250514120708-22ca98ea604a // pinned: internal/services/whatsapp/extensions calls whatsmeow internals
 	google.golang.org/protobuf v1.36.6
 )
 
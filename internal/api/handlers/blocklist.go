@@ -0,0 +1,212 @@
+// internal/api/handlers/blocklist.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// BlocklistHandler gerencia endpoints de bloqueio de contatos
+type BlocklistHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewBlocklistHandler cria um novo handler de bloqueio de contatos
+func NewBlocklistHandler(sm *whatsapp.SessionManager) *BlocklistHandler {
+	return &BlocklistHandler{
+		sessionManager: sm,
+	}
+}
+
+// BlocklistContactRequest representa a requisição para bloquear ou desbloquear um contato
+type BlocklistContactRequest struct {
+	Contact string `json:"contact" binding:"required"`
+}
+
+// submitWorkerTask submits a task to the worker system and waits for response with proper error handling
+func (h *BlocklistHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	// Get coordinator and worker pool
+	coordinator := h.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	// Ensure worker exists for user
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		logger.Debug("Creating worker for user", "user_id", userID)
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+
+		// Give worker a moment to initialize
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Create response channel with proper buffering
+	responseChan := make(chan worker.CommandResponse, 1)
+
+	// Create task
+	task := worker.Task{
+		ID:       fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:     taskType,
+		UserID:   userID,
+		Priority: worker.NormalPriority,
+		Payload:  payload,
+		Response: responseChan,
+		Created:  time.Now(),
+	}
+
+	// Submit task to worker pool
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for worker response")
+	}
+}
+
+// GetBlocklist lista os contatos bloqueados da conta
+func (h *BlocklistHandler) GetBlocklist(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetBlocklist, nil)
+	if err != nil {
+		logger.Error("Falha ao obter lista de bloqueados", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter lista de bloqueados", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// BlockContact bloqueia um contato
+func (h *BlocklistHandler) BlockContact(c *gin.Context) {
+	h.updateBlocklist(c, worker.CmdBlockContact, "bloquear", "Contato bloqueado com sucesso")
+}
+
+// UnblockContact desbloqueia um contato
+func (h *BlocklistHandler) UnblockContact(c *gin.Context) {
+	h.updateBlocklist(c, worker.CmdUnblockContact, "desbloquear", "Contato desbloqueado com sucesso")
+}
+
+// updateBlocklist envia o bloqueio ou desbloqueio ao worker e retorna a lista atualizada
+func (h *BlocklistHandler) updateBlocklist(c *gin.Context, command worker.CommandType, action, message string) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req BlocklistContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.BlocklistContactPayload{
+		Contact: req.Contact,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, command, payload)
+	if err != nil {
+		logger.Error("Falha ao "+action+" contato", "error", err, "user_id", userIDStr, "contact", req.Contact)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao " + action + " contato", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": message,
+		"data":    result,
+	})
+}
+
+// GetAutoBlockConfig retorna a regra de bloqueio automático de contatos desconhecidos
+func (h *BlocklistHandler) GetAutoBlockConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetAutoBlockConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de bloqueio automático", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de bloqueio automático", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetAutoBlockConfig atualiza a regra de bloqueio automático.
+// Campos omitidos mantêm o valor atual.
+func (h *BlocklistHandler) SetAutoBlockConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetAutoBlockConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de bloqueio automático", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de bloqueio automático", "details": err.Error()})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	config, err = h.sessionManager.SetAutoBlockConfig(userIDStr, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar configuração de bloqueio automático", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+		"message": "Configuração de bloqueio automático atualizada com sucesso",
+	})
+}
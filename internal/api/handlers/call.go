@@ -0,0 +1,82 @@
+// internal/api/handlers/call.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/pkg/logger"
+)
+
+// CallHandler gerencia endpoints de chamadas recebidas
+type CallHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewCallHandler cria um novo handler de chamadas
+func NewCallHandler(sm *whatsapp.SessionManager) *CallHandler {
+	return &CallHandler{
+		sessionManager: sm,
+	}
+}
+
+// GetRejectConfig retorna a regra de rejeição automática de chamadas
+func (h *CallHandler) GetRejectConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetCallRejectConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de rejeição de chamadas", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de rejeição de chamadas", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetRejectConfig atualiza a regra de rejeição automática de chamadas.
+// Campos omitidos mantêm o valor atual.
+func (h *CallHandler) SetRejectConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetCallRejectConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de rejeição de chamadas", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de rejeição de chamadas", "details": err.Error()})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	config, err = h.sessionManager.SetCallRejectConfig(userIDStr, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar configuração de rejeição de chamadas", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+		"message": "Configuração de rejeição de chamadas atualizada com sucesso",
+	})
+}
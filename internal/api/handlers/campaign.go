@@ -0,0 +1,234 @@
+// internal/api/handlers/campaign.go
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/campaign"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// CampaignHandler gerencia endpoints para campanhas de envio em massa
+type CampaignHandler struct {
+	campaignService *campaign.Service
+}
+
+// CampaignActionRequest representa a requisição para alterar o status de uma campanha
+type CampaignActionRequest struct {
+	CampaignID string `json:"campaign_id" binding:"required"`
+}
+
+// NewCampaignHandler cria um novo handler de campanhas
+func NewCampaignHandler(cs *campaign.Service) *CampaignHandler {
+	return &CampaignHandler{
+		campaignService: cs,
+	}
+}
+
+// CreateCampaign cria uma nova campanha.
+// Aceita JSON com a lista de destinatários ou multipart/form-data com o campo "data"
+// (JSON da campanha) e o arquivo "recipients" (CSV ou JSON).
+func (h *CampaignHandler) CreateCampaign(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req campaign.CreateRequest
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		if err := json.Unmarshal([]byte(c.PostForm("data")), &req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+			return
+		}
+
+		fileHeader, err := c.FormFile("recipients")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo de destinatários é obrigatório", "details": err.Error()})
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao ler arquivo de destinatários", "details": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		if strings.EqualFold(filepath.Ext(fileHeader.Filename), ".json") {
+			req.Recipients, err = campaign.ParseRecipientsJSON(file)
+		} else {
+			req.Recipients, err = campaign.ParseRecipientsCSV(file)
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Lista de destinatários inválida", "details": err.Error()})
+			return
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	result, err := h.campaignService.Create(userIDStr, req)
+	if err != nil {
+		logger.Error("Falha ao criar campanha", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao criar campanha", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": "Campanha criada com sucesso",
+	})
+}
+
+// ListCampaigns lista as campanhas da sessão
+func (h *CampaignHandler) ListCampaigns(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.campaignService.List(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao listar campanhas", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar campanhas", "details": err.Error()})
+		return
+	}
+
+	if result == nil {
+		result = []*storage.Campaign{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetCampaign obtém o status e o progresso de uma campanha
+func (h *CampaignHandler) GetCampaign(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	campaignID := c.Query("campaign_id")
+	if campaignID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "campaign_id query parameter is required"})
+		return
+	}
+
+	result, err := h.campaignService.Get(userIDStr, campaignID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Campanha não encontrada", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetCampaignResults lista os resultados por destinatário de uma campanha
+func (h *CampaignHandler) GetCampaignResults(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	campaignID := c.Query("campaign_id")
+	if campaignID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "campaign_id query parameter is required"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	result, err := h.campaignService.Results(userIDStr, campaignID, c.Query("status"), limit, offset)
+	if err != nil {
+		logger.Error("Falha ao obter resultados da campanha", "error", err, "user_id", userIDStr, "campaign_id", campaignID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter resultados da campanha", "details": err.Error()})
+		return
+	}
+
+	if result == nil {
+		result = []storage.CampaignRecipient{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// StartCampaign inicia o envio de uma campanha
+func (h *CampaignHandler) StartCampaign(c *gin.Context) {
+	h.changeStatus(c, h.campaignService.Start, "Falha ao iniciar campanha", "Campanha iniciada com sucesso")
+}
+
+// PauseCampaign pausa uma campanha em execução
+func (h *CampaignHandler) PauseCampaign(c *gin.Context) {
+	h.changeStatus(c, h.campaignService.Pause, "Falha ao pausar campanha", "Campanha pausada com sucesso")
+}
+
+// ResumeCampaign retoma uma campanha pausada
+func (h *CampaignHandler) ResumeCampaign(c *gin.Context) {
+	h.changeStatus(c, h.campaignService.Resume, "Falha ao retomar campanha", "Campanha retomada com sucesso")
+}
+
+// CancelCampaign cancela uma campanha
+func (h *CampaignHandler) CancelCampaign(c *gin.Context) {
+	h.changeStatus(c, h.campaignService.Cancel, "Falha ao cancelar campanha", "Campanha cancelada com sucesso")
+}
+
+// changeStatus executa uma ação de mudança de status de campanha
+func (h *CampaignHandler) changeStatus(c *gin.Context, action func(userID, campaignID string) (*storage.Campaign, error), failMsg, successMsg string) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req CampaignActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	result, err := action(userIDStr, req.CampaignID)
+	if err != nil {
+		logger.Error(failMsg, "error", err, "user_id", userIDStr, "campaign_id", req.CampaignID)
+		c.JSON(http.StatusBadRequest, gin.H{"error": failMsg, "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": successMsg,
+	})
+}
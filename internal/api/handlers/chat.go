@@ -0,0 +1,347 @@
+// internal/api/handlers/chat.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// ChatHandler gerencia endpoints para configurações das conversas do WhatsApp
+type ChatHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewChatHandler cria um novo handler de conversas
+func NewChatHandler(sm *whatsapp.SessionManager) *ChatHandler {
+	return &ChatHandler{
+		sessionManager: sm,
+	}
+}
+
+// SetChatDisappearingTimerRequest representa a requisição para alterar as mensagens temporárias de uma conversa
+type SetChatDisappearingTimerRequest struct {
+	To    string `json:"to" binding:"required"`
+	Timer string `json:"timer" binding:"required,oneof=off 24h 7d 90d"`
+}
+
+// SetDefaultDisappearingTimerRequest representa a requisição para alterar as mensagens temporárias padrão da conta
+type SetDefaultDisappearingTimerRequest struct {
+	Timer string `json:"timer" binding:"required,oneof=off 24h 7d 90d"`
+}
+
+// ArchiveChatRequest representa a requisição para arquivar ou desarquivar uma conversa
+type ArchiveChatRequest struct {
+	Chat    string `json:"chat" binding:"required"`
+	Archive bool   `json:"archive"`
+}
+
+// PinChatRequest representa a requisição para fixar ou desafixar uma conversa
+type PinChatRequest struct {
+	Chat string `json:"chat" binding:"required"`
+	Pin  bool   `json:"pin"`
+}
+
+// MuteChatRequest representa a requisição para silenciar uma conversa; duration_seconds zerado silencia para sempre
+type MuteChatRequest struct {
+	Chat            string `json:"chat" binding:"required"`
+	Mute            bool   `json:"mute"`
+	DurationSeconds int64  `json:"duration_seconds" binding:"min=0"`
+}
+
+// MarkChatUnreadRequest representa a requisição para marcar uma conversa como não lida ou lida
+type MarkChatUnreadRequest struct {
+	Chat   string `json:"chat" binding:"required"`
+	Unread bool   `json:"unread"`
+}
+
+// ChatRequest representa uma requisição que identifica apenas a conversa
+type ChatRequest struct {
+	Chat string `json:"chat" binding:"required"`
+}
+
+// submitWorkerTask submits a task to the worker system and waits for response with proper error handling
+func (h *ChatHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	// Get coordinator and worker pool
+	coordinator := h.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	// Ensure worker exists for user
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		logger.Debug("Creating worker for user", "user_id", userID)
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+
+		// Give worker a moment to initialize
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Create response channel with proper buffering
+	responseChan := make(chan worker.CommandResponse, 1)
+
+	// Create task
+	task := worker.Task{
+		ID:       fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:     taskType,
+		UserID:   userID,
+		Priority: worker.NormalPriority,
+		Payload:  payload,
+		Response: responseChan,
+		Created:  time.Now(),
+	}
+
+	// Submit task to worker pool
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for worker response")
+	}
+}
+
+// SetChatDisappearingTimer altera o temporizador de mensagens temporárias de uma conversa individual
+func (h *ChatHandler) SetChatDisappearingTimer(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetChatDisappearingTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.SetChatDisappearingTimerPayload{
+		To:    req.To,
+		Timer: req.Timer,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdSetChatDisappearingTimer, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar mensagens temporárias da conversa",
+			"error", err,
+			"user_id", userIDStr,
+			"to", req.To,
+			"timer", req.Timer)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar mensagens temporárias da conversa", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Mensagens temporárias da conversa alteradas com sucesso",
+	})
+}
+
+// SetDefaultDisappearingTimer altera o temporizador padrão de mensagens temporárias das novas conversas
+func (h *ChatHandler) SetDefaultDisappearingTimer(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetDefaultDisappearingTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.SetDefaultDisappearingTimerPayload{
+		Timer: req.Timer,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdSetDefaultDisappearingTimer, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar mensagens temporárias padrão",
+			"error", err,
+			"user_id", userIDStr,
+			"timer", req.Timer)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar mensagens temporárias padrão", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Mensagens temporárias padrão alteradas com sucesso",
+	})
+}
+
+// ListChats lista as conversas armazenadas com a última mensagem e o estado de cada uma
+func (h *ChatHandler) ListChats(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	chats, total, err := h.sessionManager.ListChats(userIDStr, limit, offset)
+	if err != nil {
+		logger.Error("Falha ao listar conversas", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar conversas", "details": err.Error()})
+		return
+	}
+
+	if chats == nil {
+		chats = []storage.Chat{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    chats,
+		"total":   total,
+	})
+}
+
+// ArchiveChat arquiva ou desarquiva uma conversa
+func (h *ChatHandler) ArchiveChat(c *gin.Context) {
+	var req ArchiveChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.ArchiveChatPayload{
+		Chat:    req.Chat,
+		Archive: req.Archive,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdArchiveChat, payload, "Falha ao arquivar conversa", "Conversa atualizada com sucesso")
+}
+
+// PinChat fixa ou desafixa uma conversa
+func (h *ChatHandler) PinChat(c *gin.Context) {
+	var req PinChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.PinChatPayload{
+		Chat: req.Chat,
+		Pin:  req.Pin,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdPinChat, payload, "Falha ao fixar conversa", "Conversa atualizada com sucesso")
+}
+
+// MuteChat silencia uma conversa ou reativa as notificações
+func (h *ChatHandler) MuteChat(c *gin.Context) {
+	var req MuteChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.MuteChatPayload{
+		Chat:     req.Chat,
+		Mute:     req.Mute,
+		Duration: time.Duration(req.DurationSeconds) * time.Second,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdMuteChat, payload, "Falha ao silenciar conversa", "Conversa atualizada com sucesso")
+}
+
+// MarkChatUnread marca uma conversa como não lida ou lida
+func (h *ChatHandler) MarkChatUnread(c *gin.Context) {
+	var req MarkChatUnreadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.MarkChatUnreadPayload{
+		Chat:   req.Chat,
+		Unread: req.Unread,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdMarkChatUnread, payload, "Falha ao marcar conversa", "Conversa atualizada com sucesso")
+}
+
+// ClearChat apaga as mensagens de uma conversa, mantendo a conversa
+func (h *ChatHandler) ClearChat(c *gin.Context) {
+	var req ChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.ChatPayload{
+		Chat: req.Chat,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdClearChat, payload, "Falha ao limpar conversa", "Conversa limpa com sucesso")
+}
+
+// DeleteChat apaga uma conversa
+func (h *ChatHandler) DeleteChat(c *gin.Context) {
+	var req ChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.ChatPayload{
+		Chat: req.Chat,
+	}
+
+	h.submitChatAction(c, req.Chat, worker.CmdDeleteChat, payload, "Falha ao apagar conversa", "Conversa apagada com sucesso")
+}
+
+// submitChatAction envia a ação sobre a conversa ao worker e responde com o resultado
+func (h *ChatHandler) submitChatAction(c *gin.Context, chat string, command worker.CommandType, payload interface{}, failure, success string) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	_, err := h.submitWorkerTask(userIDStr, command, payload)
+	if err != nil {
+		logger.Error(failure, "error", err, "user_id", userIDStr, "chat", chat)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": failure, "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": success,
+	})
+}
@@ -75,6 +75,16 @@ type RevokeCommunityInviteLinkRequest struct {
 	CommunityJID string `json:"community_jid" binding:"required"`
 }
 
+// SendCommunityAnnouncementRequest representa um aviso enviado ao grupo de avisos da comunidade.
+// Com media_url a mídia é enviada com a legenda; caso contrário, message é enviada como texto.
+type SendCommunityAnnouncementRequest struct {
+	CommunityJID string `json:"community_jid" binding:"required"`
+	Message      string `json:"message"`
+	MediaURL     string `json:"media_url"`
+	MediaType    string `json:"media_type" binding:"required_with=MediaURL"`
+	Caption      string `json:"caption"`
+}
+
 // CommunityHandler gerencia endpoints para operações de comunidades
 type CommunityHandler struct {
 	sessionManager *whatsapp.SessionManager
@@ -714,3 +724,85 @@ func (h *CommunityHandler) GetCommunityLinkedGroups(c *gin.Context) {
 		"data":    result,
 	})
 }
+
+// GetCommunityMembers consolida os membros de todos os grupos vinculados à comunidade
+func (h *CommunityHandler) GetCommunityMembers(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	communityJID := c.Query("community_jid")
+	if communityJID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "community_jid query parameter is required"})
+		return
+	}
+
+	payload := worker.CommunityMembersPayload{
+		CommunityJID: communityJID,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetCommunityMembers, payload)
+	if err != nil {
+		logger.Error("Falha ao obter membros da comunidade",
+			"error", err,
+			"user_id", userIDStr,
+			"community_jid", communityJID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter membros da comunidade", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// SendCommunityAnnouncement envia um aviso ao grupo de avisos da comunidade
+func (h *CommunityHandler) SendCommunityAnnouncement(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SendCommunityAnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	if req.Message == "" && req.MediaURL == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "É necessário fornecer message ou media_url"})
+		return
+	}
+
+	payload := worker.SendCommunityAnnouncementPayload{
+		CommunityJID: req.CommunityJID,
+		Message:      req.Message,
+		MediaURL:     req.MediaURL,
+		MediaType:    req.MediaType,
+		Caption:      req.Caption,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdSendCommunityAnnouncement, payload)
+	if err != nil {
+		logger.Error("Falha ao enviar aviso à comunidade",
+			"error", err,
+			"user_id", userIDStr,
+			"community_jid", req.CommunityJID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao enviar aviso à comunidade", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": "Aviso enviado à comunidade com sucesso",
+	})
+}
@@ -0,0 +1,123 @@
+// internal/api/handlers/contact.go
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// ContactHandler gerencia endpoints dos contatos armazenados da sessão
+type ContactHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+type ImportContactsRequest struct {
+	Contacts []whatsapp.ContactImportEntry `json:"contacts" binding:"required,min=1,max=1000,dive"`
+}
+
+// NewContactHandler cria um novo handler de contatos
+func NewContactHandler(sm *whatsapp.SessionManager) *ContactHandler {
+	return &ContactHandler{
+		sessionManager: sm,
+	}
+}
+
+// ListContacts lista os contatos armazenados, buscando por nome ou número quando search é informado
+func (h *ContactHandler) ListContacts(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	search := c.Query("search")
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	contacts, total, err := h.sessionManager.ListContacts(userIDStr, search, limit, offset)
+	if err != nil {
+		logger.Error("Falha ao listar contatos", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar contatos", "details": err.Error()})
+		return
+	}
+
+	if contacts == nil {
+		contacts = []storage.Contact{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    contacts,
+		"total":   total,
+	})
+}
+
+// GetContact retorna um contato armazenado pelo JID ou número, junto com a conversa
+func (h *ContactHandler) GetContact(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	contact := c.Query("contact")
+	if contact == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "contact query parameter is required"})
+		return
+	}
+
+	details, err := h.sessionManager.GetContact(userIDStr, contact)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao obter contato", "details": err.Error()})
+		return
+	}
+	if details == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Contato não encontrado", "details": contact})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    details,
+	})
+}
+
+// ImportContacts importa contatos da agenda, resolvendo no WhatsApp o JID de cada número
+func (h *ContactHandler) ImportContacts(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req ImportContactsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	result, err := h.sessionManager.ImportContacts(userIDStr, req.Contacts)
+	if err != nil {
+		logger.Error("Falha ao importar contatos", "error", err, "user_id", userIDStr, "count", len(req.Contacts))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao importar contatos", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": "Contatos importados com sucesso",
+	})
+}
@@ -0,0 +1,186 @@
+// internal/api/handlers/export.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/export"
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// Rotas de acompanhamento e download das exportações em segundo plano
+const (
+	groupExportStatusPath   = "/api/v1/group/participants/export/status?job_id="
+	groupExportDownloadPath = "/api/v1/group/participants/export/download?job_id="
+)
+
+// ExportHandler gerencia endpoints de exportação de participantes de grupos
+type ExportHandler struct {
+	sessionManager *whatsapp.SessionManager
+	exportService  *export.Service
+}
+
+// NewExportHandler cria um novo handler de exportação
+func NewExportHandler(sm *whatsapp.SessionManager, es *export.Service) *ExportHandler {
+	return &ExportHandler{
+		sessionManager: sm,
+		exportService:  es,
+	}
+}
+
+// ExportGroupParticipants exporta os participantes dos grupos em CSV ou NDJSON (?format=csv|ndjson).
+// Aceita ?group_jids=a,b (ou group_jid repetido); sem grupos, exporta todos em que a sessão é admin.
+// Exportações grandes, ou com ?async=true, rodam em segundo plano e retornam o link de download.
+func (h *ExportHandler) ExportGroupParticipants(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	format := c.DefaultQuery("format", export.FormatCSV)
+	if format != export.FormatCSV && format != export.FormatNDJSON {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format deve ser csv ou ndjson"})
+		return
+	}
+
+	fresh, _ := strconv.ParseBool(c.DefaultQuery("fresh", "false"))
+	async, _ := strconv.ParseBool(c.DefaultQuery("async", "false"))
+
+	var groupJIDs []string
+	for _, value := range append(c.QueryArray("group_jid"), c.QueryArray("group_jids")...) {
+		for _, jid := range strings.Split(value, ",") {
+			if jid = strings.TrimSpace(jid); jid != "" {
+				groupJIDs = append(groupJIDs, jid)
+			}
+		}
+	}
+
+	targets, err := h.sessionManager.GroupExportTargets(userIDStr, groupJIDs, fresh)
+	if err != nil {
+		logger.Error("Falha ao obter grupos para exportação", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter grupos para exportação", "details": err.Error()})
+		return
+	}
+
+	if async || len(targets) > export.MaxInlineGroups {
+		job, err := h.exportService.StartGroupParticipants(userIDStr, targets, format, fresh)
+		if err != nil {
+			logger.Error("Falha ao iniciar exportação", "error", err, "user_id", userIDStr)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao iniciar exportação", "details": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, gin.H{
+			"success": true,
+			"data":    exportJobResponse(job),
+			"message": "Exportação iniciada em segundo plano",
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("group_participants_%s.%s", time.Now().Format("20060102_150405"), format)
+	c.Header("Content-Type", export.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+
+	writer, err := export.NewParticipantWriter(c.Writer, format)
+	if err == nil {
+		err = h.sessionManager.ExportGroupParticipants(userIDStr, targets, fresh, writer.Write)
+	}
+	if err != nil {
+		logger.Error("Falha ao exportar participantes", "error", err, "user_id", userIDStr)
+
+		// Depois que as primeiras linhas foram enviadas não há como trocar a resposta
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Disposition")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao exportar participantes", "details": err.Error()})
+		}
+		return
+	}
+
+	logger.Debug("Participantes exportados",
+		"user_id", userIDStr,
+		"groups", len(targets),
+		"rows", writer.Rows())
+}
+
+// GetGroupParticipantsExport retorna o status de uma exportação em segundo plano
+func (h *ExportHandler) GetGroupParticipantsExport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	jobID := c.Query("job_id")
+	if jobID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "job_id query parameter is required"})
+		return
+	}
+
+	job, err := h.exportService.Get(userIDStr, jobID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Exportação não encontrada", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    exportJobResponse(job),
+	})
+}
+
+// DownloadGroupParticipantsExport envia o arquivo de uma exportação concluída
+func (h *ExportHandler) DownloadGroupParticipantsExport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	jobID := c.Query("job_id")
+	if jobID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "job_id query parameter is required"})
+		return
+	}
+
+	job, err := h.exportService.Get(userIDStr, jobID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Exportação não encontrada", "details": err.Error()})
+		return
+	}
+
+	if job.Status != export.StatusCompleted {
+		c.JSON(http.StatusConflict, gin.H{"error": "Exportação não está concluída", "status": job.Status, "details": job.Error})
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType(job.Format))
+	c.FileAttachment(job.FilePath, fmt.Sprintf("group_participants_%s.%s", job.ID, job.Format))
+}
+
+// exportJobResponse adiciona o link de download às exportações concluídas
+func exportJobResponse(job *storage.ExportJob) gin.H {
+	response := gin.H{
+		"job":        job,
+		"status_url": groupExportStatusPath + job.ID,
+	}
+	if job.Status == export.StatusCompleted {
+		response["download_url"] = groupExportDownloadPath + job.ID
+	}
+	return response
+}
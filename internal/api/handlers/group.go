@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -37,6 +38,9 @@ type CreateGroupRequest struct {
 type AddParticipantsRequest struct {
 	GroupJID     string   `json:"group_jid" binding:"required"`
 	Participants []string `json:"participants" binding:"required,min=1"`
+	// Enviar convite a quem não pode ser adicionado por privacidade
+	SendInvite    bool   `json:"send_invite"`
+	InviteCaption string `json:"invite_caption"`
 }
 
 // RemoveParticipantsRequest representa a requisição para remover participantes
@@ -125,6 +129,19 @@ type SetGroupMemberAddModeRequest struct {
 	Mode     string `json:"mode" binding:"required,oneof=admin_add all_member_add"`
 }
 
+// SetGroupDisappearingTimerRequest representa a requisição para alterar as mensagens temporárias do grupo
+type SetGroupDisappearingTimerRequest struct {
+	GroupJID string `json:"group_jid" binding:"required"`
+	Timer    string `json:"timer" binding:"required,oneof=off 24h 7d 90d"`
+}
+
+// GroupJoinRequestsRequest representa a requisição para aprovar ou rejeitar solicitações de entrada.
+// Sem participantes, a ação é aplicada a todas as solicitações pendentes.
+type GroupJoinRequestsRequest struct {
+	GroupJID     string   `json:"group_jid" binding:"required"`
+	Participants []string `json:"participants"`
+}
+
 // submitWorkerTask submits a task to the worker system and waits for response with proper error handling
 func (h *GroupHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
 	// Get coordinator and worker pool
@@ -251,9 +268,13 @@ func (h *GroupHandler) GetGroupInfo(c *gin.Context) {
 		return
 	}
 
+	// fresh=true ignora o cache e consulta o WhatsApp
+	fresh, _ := strconv.ParseBool(c.DefaultQuery("fresh", "false"))
+
 	// Create payload
 	payload := worker.GroupInfoPayload{
 		GroupJID: groupJID,
+		Fresh:    fresh,
 	}
 
 	// Submit task to worker
@@ -283,8 +304,11 @@ func (h *GroupHandler) GetJoinedGroups(c *gin.Context) {
 
 	userIDStr := userID.(string)
 
-	// Submit task to worker (no payload needed)
-	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetJoinedGroups, nil)
+	// fresh=true ignora o cache e consulta o WhatsApp
+	fresh, _ := strconv.ParseBool(c.DefaultQuery("fresh", "false"))
+
+	// Submit task to worker
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetJoinedGroups, worker.JoinedGroupsPayload{Fresh: fresh})
 	if err != nil {
 		logger.Error("Falha ao obter lista de grupos", "error", err, "user_id", userIDStr)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter lista de grupos", "details": err.Error()})
@@ -307,52 +331,25 @@ func (h *GroupHandler) GetAdminGroups(c *gin.Context) {
 
 	userIDStr := userID.(string)
 
+	// fresh=true ignora o cache e consulta o WhatsApp
+	fresh, _ := strconv.ParseBool(c.DefaultQuery("fresh", "false"))
+
 	// Submit task to worker to get all joined groups
-	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetJoinedGroups, nil)
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetJoinedGroups, worker.JoinedGroupsPayload{Fresh: fresh})
 	if err != nil {
 		logger.Error("Falha ao obter lista de grupos", "error", err, "user_id", userIDStr)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter lista de grupos", "details": err.Error()})
 		return
 	}
 
-	// Get user's session to get their JID
-	session, exists := h.sessionManager.GetSession(userIDStr)
-	if !exists {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Sessão não encontrada"})
-		return
-	}
-
-	if !session.IsActive() {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Sessão não está ativa ou conectada"})
-		return
-	}
-
-	// Get user's JID
-	userJID := session.GetWAClient().Store.ID
-	if userJID == nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter JID do usuário"})
-		return
-	}
-
 	// Convert result to proper type and filter admin groups
 	adminGroups := make([]interface{}, 0)
-	
+
 	// Handle the result type properly
 	switch groups := result.(type) {
-	case []messaging.GroupInfo:
-		for _, group := range groups {
-			// Check if user is admin in this group
-			isAdmin := false
-			for _, participant := range group.Participants {
-				// Compare JID to see if this participant is the current user and is admin
-				if participant.JID == userJID.String() && (participant.IsAdmin || participant.IsSuperAdmin) {
-					isAdmin = true
-					break
-				}
-			}
-			if isAdmin {
-				adminGroups = append(adminGroups, group)
-			}
+	case []*messaging.GroupInfo:
+		for _, group := range messaging.FilterAdminGroups(groups) {
+			adminGroups = append(adminGroups, group)
 		}
 	default:
 		logger.Error("Unexpected result type from GetJoinedGroups", "type", fmt.Sprintf("%T", result))
@@ -388,12 +385,14 @@ func (h *GroupHandler) AddParticipants(c *gin.Context) {
 
 	// Create payload
 	payload := worker.GroupParticipantsPayload{
-		GroupJID:     req.GroupJID,
-		Participants: req.Participants,
+		GroupJID:      req.GroupJID,
+		Participants:  req.Participants,
+		SendInvite:    req.SendInvite,
+		InviteCaption: req.InviteCaption,
 	}
 
 	// Submit task to worker
-	_, err := h.submitWorkerTask(userIDStr, worker.CmdAddGroupParticipants, payload)
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdAddGroupParticipants, payload)
 	if err != nil {
 		logger.Error("Falha ao adicionar participantes",
 			"error", err,
@@ -405,6 +404,7 @@ func (h *GroupHandler) AddParticipants(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
+		"data":    result,
 		"message": "Participantes adicionados com sucesso",
 	})
 }
@@ -432,7 +432,7 @@ func (h *GroupHandler) RemoveParticipants(c *gin.Context) {
 	}
 
 	// Submit task to worker
-	_, err := h.submitWorkerTask(userIDStr, worker.CmdRemoveGroupParticipants, payload)
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdRemoveGroupParticipants, payload)
 	if err != nil {
 		logger.Error("Falha ao remover participantes",
 			"error", err,
@@ -444,6 +444,7 @@ func (h *GroupHandler) RemoveParticipants(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
+		"data":    result,
 		"message": "Participantes removidos com sucesso",
 	})
 }
@@ -471,7 +472,7 @@ func (h *GroupHandler) PromoteParticipants(c *gin.Context) {
 	}
 
 	// Submit task to worker
-	_, err := h.submitWorkerTask(userIDStr, worker.CmdPromoteGroupParticipants, payload)
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdPromoteGroupParticipants, payload)
 	if err != nil {
 		logger.Error("Falha ao promover participantes",
 			"error", err,
@@ -483,6 +484,7 @@ func (h *GroupHandler) PromoteParticipants(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
+		"data":    result,
 		"message": "Participantes promovidos com sucesso",
 	})
 }
@@ -510,7 +512,7 @@ func (h *GroupHandler) DemoteParticipants(c *gin.Context) {
 	}
 
 	// Submit task to worker
-	_, err := h.submitWorkerTask(userIDStr, worker.CmdDemoteGroupParticipants, payload)
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdDemoteGroupParticipants, payload)
 	if err != nil {
 		logger.Error("Falha ao rebaixar participantes",
 			"error", err,
@@ -522,6 +524,7 @@ func (h *GroupHandler) DemoteParticipants(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
+		"data":    result,
 		"message": "Participantes rebaixados com sucesso",
 	})
 }
@@ -757,6 +760,45 @@ func (h *GroupHandler) GetGroupInviteLink(c *gin.Context) {
 	})
 }
 
+// GetGroupInviteInfo obtém as informações de um grupo ou comunidade pelo link de convite, sem entrar
+func (h *GroupHandler) GetGroupInviteInfo(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	// Get link from query parameters
+	link := c.Query("link")
+	if link == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "link query parameter is required"})
+		return
+	}
+
+	// Create payload
+	payload := worker.GroupInviteInfoPayload{
+		Link: link,
+	}
+
+	// Submit task to worker
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetGroupInviteInfo, payload)
+	if err != nil {
+		logger.Error("Falha ao obter informações do convite",
+			"error", err,
+			"user_id", userIDStr,
+			"link", link)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter informações do convite", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // RevokeGroupInviteLink revoga o link atual e gera um novo
 func (h *GroupHandler) RevokeGroupInviteLink(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -951,3 +993,134 @@ func (h *GroupHandler) SetGroupMemberAddMode(c *gin.Context) {
 		"message": "Modo de adição de membros do grupo alterado com sucesso",
 	})
 }
+
+// SetGroupDisappearingTimer altera o temporizador de mensagens temporárias do grupo
+func (h *GroupHandler) SetGroupDisappearingTimer(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetGroupDisappearingTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	// Create payload
+	payload := worker.SetGroupDisappearingTimerPayload{
+		GroupJID: req.GroupJID,
+		Timer:    req.Timer,
+	}
+
+	// Submit task to worker
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdSetGroupDisappearingTimer, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar mensagens temporárias do grupo",
+			"error", err,
+			"user_id", userIDStr,
+			"group_jid", req.GroupJID,
+			"timer", req.Timer)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar mensagens temporárias do grupo", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Mensagens temporárias do grupo alteradas com sucesso",
+	})
+}
+
+// GetGroupJoinRequests lista as solicitações pendentes de entrada no grupo
+func (h *GroupHandler) GetGroupJoinRequests(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	// Get group_jid from query parameters
+	groupJID := c.Query("group_jid")
+	if groupJID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "group_jid query parameter is required"})
+		return
+	}
+
+	// Create payload
+	payload := worker.GroupJoinRequestsPayload{
+		GroupJID: groupJID,
+	}
+
+	// Submit task to worker
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetGroupJoinRequests, payload)
+	if err != nil {
+		logger.Error("Falha ao obter solicitações de entrada do grupo",
+			"error", err,
+			"user_id", userIDStr,
+			"group_jid", groupJID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter solicitações de entrada do grupo", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ApproveGroupJoinRequests aprova solicitações de entrada no grupo
+func (h *GroupHandler) ApproveGroupJoinRequests(c *gin.Context) {
+	h.updateGroupJoinRequests(c, "approve", "Solicitações de entrada aprovadas")
+}
+
+// RejectGroupJoinRequests rejeita solicitações de entrada no grupo
+func (h *GroupHandler) RejectGroupJoinRequests(c *gin.Context) {
+	h.updateGroupJoinRequests(c, "reject", "Solicitações de entrada rejeitadas")
+}
+
+// updateGroupJoinRequests aplica a ação às solicitações de entrada informadas ou a todas as pendentes
+func (h *GroupHandler) updateGroupJoinRequests(c *gin.Context, action, message string) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req GroupJoinRequestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	// Create payload
+	payload := worker.UpdateGroupJoinRequestsPayload{
+		GroupJID:     req.GroupJID,
+		Participants: req.Participants,
+		Action:       action,
+	}
+
+	// Submit task to worker
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdUpdateGroupJoinRequests, payload)
+	if err != nil {
+		logger.Error("Falha ao atualizar solicitações de entrada do grupo",
+			"error", err,
+			"user_id", userIDStr,
+			"group_jid", req.GroupJID,
+			"action", action)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao atualizar solicitações de entrada do grupo", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": message,
+	})
+}
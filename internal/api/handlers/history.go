@@ -0,0 +1,138 @@
+// internal/api/handlers/history.go
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// HistoryHandler gerencia endpoints do histórico sincronizado após o pareamento
+type HistoryHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewHistoryHandler cria um novo handler de histórico
+func NewHistoryHandler(sm *whatsapp.SessionManager) *HistoryHandler {
+	return &HistoryHandler{
+		sessionManager: sm,
+	}
+}
+
+// GetSyncConfig retorna a configuração de sincronização de histórico
+func (h *HistoryHandler) GetSyncConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetHistorySyncConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de sincronização de histórico", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de sincronização de histórico", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetSyncConfig atualiza a configuração de sincronização de histórico.
+// Campos omitidos mantêm o valor atual.
+func (h *HistoryHandler) SetSyncConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetHistorySyncConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de sincronização de histórico", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de sincronização de histórico", "details": err.Error()})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	config, err = h.sessionManager.SetHistorySyncConfig(userIDStr, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar configuração de sincronização de histórico", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+		"message": "Configuração de sincronização de histórico atualizada com sucesso",
+	})
+}
+
+// ListMessages lista as mensagens armazenadas de um chat, das mais recentes para as mais antigas.
+// before (unix) pagina a partir da mensagem mais antiga já recebida.
+func (h *HistoryHandler) ListMessages(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	chat := c.Query("chat")
+	if chat == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "chat query parameter is required"})
+		return
+	}
+
+	chatJID, err := types.ParseJID(chat)
+	if err != nil || chatJID.Server == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JID do chat inválido", "details": chat})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	var before time.Time
+	if beforeStr := c.Query("before"); beforeStr != "" {
+		beforeUnix, err := strconv.ParseInt(beforeStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Parâmetro before inválido", "details": err.Error()})
+			return
+		}
+		before = time.Unix(beforeUnix, 0)
+	}
+
+	messages, err := h.sessionManager.ListMessages(userIDStr, chatJID.String(), before, limit)
+	if err != nil {
+		logger.Error("Falha ao listar mensagens do histórico", "error", err, "user_id", userIDStr, "chat", chat)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar mensagens do histórico", "details": err.Error()})
+		return
+	}
+
+	if messages == nil {
+		messages = []storage.StoredMessage{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    messages,
+	})
+}
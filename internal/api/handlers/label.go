@@ -0,0 +1,288 @@
+// internal/api/handlers/label.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// LabelHandler gerencia endpoints das etiquetas do WhatsApp Business
+type LabelHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewLabelHandler cria um novo handler de etiquetas
+func NewLabelHandler(sm *whatsapp.SessionManager) *LabelHandler {
+	return &LabelHandler{
+		sessionManager: sm,
+	}
+}
+
+// CreateLabelRequest representa a requisição para criar uma etiqueta
+type CreateLabelRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Color int32  `json:"color" binding:"min=0,max=19"`
+}
+
+// EditLabelRequest representa a requisição para editar uma etiqueta
+type EditLabelRequest struct {
+	LabelID string `json:"label_id" binding:"required"`
+	Name    string `json:"name" binding:"required"`
+	Color   int32  `json:"color" binding:"min=0,max=19"`
+}
+
+// DeleteLabelRequest representa a requisição para apagar uma etiqueta
+type DeleteLabelRequest struct {
+	LabelID string `json:"label_id" binding:"required"`
+}
+
+// LabelChatRequest representa a requisição para adicionar ou remover uma etiqueta de uma conversa
+type LabelChatRequest struct {
+	Chat    string `json:"chat" binding:"required"`
+	LabelID string `json:"label_id" binding:"required"`
+	Labeled bool   `json:"labeled"`
+}
+
+// LabelMessageRequest representa a requisição para adicionar ou remover uma etiqueta de uma mensagem
+type LabelMessageRequest struct {
+	Chat      string `json:"chat" binding:"required"`
+	MessageID string `json:"message_id" binding:"required"`
+	LabelID   string `json:"label_id" binding:"required"`
+	Labeled   bool   `json:"labeled"`
+}
+
+// submitWorkerTask submits a task to the worker system and waits for response with proper error handling
+func (h *LabelHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	// Get coordinator and worker pool
+	coordinator := h.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	// Ensure worker exists for user
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		logger.Debug("Creating worker for user", "user_id", userID)
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+
+		// Give worker a moment to initialize
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Create response channel with proper buffering
+	responseChan := make(chan worker.CommandResponse, 1)
+
+	// Create task
+	task := worker.Task{
+		ID:       fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:     taskType,
+		UserID:   userID,
+		Priority: worker.NormalPriority,
+		Payload:  payload,
+		Response: responseChan,
+		Created:  time.Now(),
+	}
+
+	// Submit task to worker pool
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for worker response")
+	}
+}
+
+// ListLabels lista as etiquetas armazenadas com a quantidade de conversas de cada uma
+func (h *LabelHandler) ListLabels(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	labels, err := h.sessionManager.ListLabels(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao listar etiquetas", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar etiquetas", "details": err.Error()})
+		return
+	}
+
+	if labels == nil {
+		labels = []storage.Label{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    labels,
+		"total":   len(labels),
+	})
+}
+
+// ListLabelChats lista as conversas que têm a etiqueta informada
+func (h *LabelHandler) ListLabelChats(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	labelID := c.Query("label_id")
+	if labelID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "label_id query parameter is required"})
+		return
+	}
+
+	chats, err := h.sessionManager.ListLabelChats(userIDStr, labelID)
+	if err != nil {
+		logger.Error("Falha ao listar conversas da etiqueta", "error", err, "user_id", userIDStr, "label_id", labelID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar conversas da etiqueta", "details": err.Error()})
+		return
+	}
+
+	if chats == nil {
+		chats = []string{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    chats,
+		"total":   len(chats),
+	})
+}
+
+// CreateLabel cria uma etiqueta
+func (h *LabelHandler) CreateLabel(c *gin.Context) {
+	var req CreateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.LabelPayload{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	h.submitLabelAction(c, "", worker.CmdCreateLabel, payload, "Falha ao criar etiqueta", "Etiqueta criada com sucesso")
+}
+
+// EditLabel altera o nome e a cor de uma etiqueta
+func (h *LabelHandler) EditLabel(c *gin.Context) {
+	var req EditLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.LabelPayload{
+		LabelID: req.LabelID,
+		Name:    req.Name,
+		Color:   req.Color,
+	}
+
+	h.submitLabelAction(c, req.LabelID, worker.CmdEditLabel, payload, "Falha ao editar etiqueta", "Etiqueta editada com sucesso")
+}
+
+// DeleteLabel apaga uma etiqueta
+func (h *LabelHandler) DeleteLabel(c *gin.Context) {
+	var req DeleteLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.LabelPayload{
+		LabelID: req.LabelID,
+	}
+
+	h.submitLabelAction(c, req.LabelID, worker.CmdDeleteLabel, payload, "Falha ao apagar etiqueta", "Etiqueta apagada com sucesso")
+}
+
+// LabelChat adiciona ou remove uma etiqueta de uma conversa
+func (h *LabelHandler) LabelChat(c *gin.Context) {
+	var req LabelChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.LabelChatPayload{
+		Chat:    req.Chat,
+		LabelID: req.LabelID,
+		Labeled: req.Labeled,
+	}
+
+	h.submitLabelAction(c, req.LabelID, worker.CmdLabelChat, payload, "Falha ao etiquetar conversa", "Conversa atualizada com sucesso")
+}
+
+// LabelMessage adiciona ou remove uma etiqueta de uma mensagem
+func (h *LabelHandler) LabelMessage(c *gin.Context) {
+	var req LabelMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.LabelMessagePayload{
+		Chat:      req.Chat,
+		MessageID: req.MessageID,
+		LabelID:   req.LabelID,
+		Labeled:   req.Labeled,
+	}
+
+	h.submitLabelAction(c, req.LabelID, worker.CmdLabelMessage, payload, "Falha ao etiquetar mensagem", "Mensagem atualizada com sucesso")
+}
+
+// submitLabelAction envia a ação sobre a etiqueta ao worker e responde com o resultado
+func (h *LabelHandler) submitLabelAction(c *gin.Context, labelID string, command worker.CommandType, payload interface{}, failure, success string) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.submitWorkerTask(userIDStr, command, payload)
+	if err != nil {
+		logger.Error(failure, "error", err, "user_id", userIDStr, "label_id", labelID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": failure, "details": err.Error()})
+		return
+	}
+
+	response := gin.H{
+		"success": true,
+		"message": success,
+	}
+	if _, isText := result.(string); !isText && result != nil {
+		response["data"] = result
+	}
+
+	c.JSON(http.StatusOK, response)
+}
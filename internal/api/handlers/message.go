@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/messaging"
 	"yourproject/internal/services/whatsapp/worker"
 	"yourproject/pkg/logger"
 )
@@ -30,6 +31,17 @@ type MediaMessageRequest struct {
 	MediaType string `json:"media_type" binding:"required"`
 }
 
+type AlbumMessageRequest struct {
+	To    string             `json:"to" binding:"required"`
+	Items []AlbumItemRequest `json:"items" binding:"required,min=2,max=30,dive"`
+}
+
+type AlbumItemRequest struct {
+	MediaURL  string `json:"media_url" binding:"required"`
+	MediaType string `json:"media_type" binding:"required,oneof=image video"`
+	Caption   string `json:"caption"`
+}
+
 type ButtonMessageRequest struct {
 	To      string              `json:"to" binding:"required"`
 	Text    string              `json:"text" binding:"required"`
@@ -55,6 +67,10 @@ type CheckNumberResponse struct {
 	Status string `json:"status"`
 }
 
+type CheckNumbersRequest struct {
+	Numbers []string `json:"numbers" binding:"required,min=1,max=1000"`
+}
+
 type MessageResponse struct {
 	MessageID string `json:"message_id"`
 	Status    string `json:"status"`
@@ -68,6 +84,11 @@ func NewMessageHandler(sm *whatsapp.SessionManager) *MessageHandler {
 
 // submitWorkerTask submits a task to the worker system and waits for response with proper error handling
 func (h *MessageHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	return h.submitWorkerTaskWithTimeout(userID, taskType, payload, 30*time.Second)
+}
+
+// submitWorkerTaskWithTimeout submits a task to the worker system and waits up to timeout for its response
+func (h *MessageHandler) submitWorkerTaskWithTimeout(userID string, taskType worker.CommandType, payload interface{}, timeout time.Duration) (interface{}, error) {
 	// Get coordinator and worker pool
 	coordinator := h.sessionManager.GetCoordinator()
 	if coordinator == nil {
@@ -112,7 +133,7 @@ func (h *MessageHandler) submitWorkerTask(userID string, taskType worker.Command
 	}
 
 	// Wait for response with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	select {
@@ -122,10 +143,20 @@ func (h *MessageHandler) submitWorkerTask(userID string, taskType worker.Command
 		}
 		return response.Data, nil
 	case <-ctx.Done():
-		return nil, fmt.Errorf("task timeout after 30 seconds")
+		return nil, fmt.Errorf("task timeout after %s", timeout)
 	}
 }
 
+// sendErrorResponse responde a uma falha de envio, com código próprio para destinatários com opt-out
+func sendErrorResponse(c *gin.Context, message string, err error) {
+	if messaging.IsSuppressedError(err) {
+		c.JSON(http.StatusForbidden, gin.H{"error": message, "code": messaging.ErrCodeRecipientSuppressed, "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{"error": message, "details": err.Error()})
+}
+
 // SendText envia uma mensagem de texto
 func (h *MessageHandler) SendText(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -165,7 +196,7 @@ func (h *MessageHandler) SendText(c *gin.Context) {
 	result, err := h.submitWorkerTask(userIDStr, worker.CmdSendText, payload)
 	if err != nil {
 		logger.Error("Falha ao enviar mensagem", "error", err, "user_id", userIDStr, "to", req.To)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao enviar mensagem", "details": err.Error()})
+		sendErrorResponse(c, "Falha ao enviar mensagem", err)
 		return
 	}
 
@@ -228,7 +259,7 @@ func (h *MessageHandler) SendMedia(c *gin.Context) {
 	result, err := h.submitWorkerTask(userIDStr, worker.CmdSendMedia, payload)
 	if err != nil {
 		logger.Error("Falha ao enviar mídia", "error", err, "user_id", userIDStr, "to", req.To)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao enviar mídia", "details": err.Error()})
+		sendErrorResponse(c, "Falha ao enviar mídia", err)
 		return
 	}
 
@@ -244,6 +275,84 @@ func (h *MessageHandler) SendMedia(c *gin.Context) {
 	})
 }
 
+// SendAlbum envia várias imagens e vídeos agrupados como álbum.
+// Itens que falharem são informados no resultado sem impedir o envio dos demais.
+func (h *MessageHandler) SendAlbum(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req AlbumMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	// Verificar se a sessão existe
+	client, exists := h.sessionManager.GetSession(userIDStr)
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sessão não encontrada"})
+		return
+	}
+
+	// Verificar se o cliente está conectado
+	if !client.Connected {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cliente não está conectado"})
+		return
+	}
+
+	items := make([]worker.AlbumItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, worker.AlbumItem{
+			MediaURL:  item.MediaURL,
+			MediaType: item.MediaType,
+			Caption:   item.Caption,
+		})
+	}
+
+	// Create payload
+	payload := worker.SendAlbumPayload{
+		To:    req.To,
+		Items: items,
+	}
+
+	// Albums send one message per item, so wait for the worst case instead of the default timeout;
+	// giving up early would make clients retry while the worker is still sending
+	pacing := time.Duration(worker.DefaultRateLimitConfig().MaxQueueWaitSeconds) * time.Second
+	if rateLimit, err := h.sessionManager.GetRateLimit(userIDStr); err == nil {
+		pacing = time.Duration(rateLimit.Config.MaxQueueWaitSeconds) * time.Second
+	}
+
+	// Submit task to worker
+	result, err := h.submitWorkerTaskWithTimeout(userIDStr, worker.CmdSendAlbum, payload, messaging.AlbumTimeout(len(items), pacing))
+	if err != nil {
+		logger.Error("Falha ao enviar álbum", "error", err, "user_id", userIDStr, "to", req.To)
+		sendErrorResponse(c, "Falha ao enviar álbum", err)
+		return
+	}
+
+	album, ok := result.(*messaging.AlbumResult)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Resposta inválida do worker"})
+		return
+	}
+
+	status := "sent"
+	if album.Failed > 0 {
+		status = "partial"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"status":  status,
+		"data":    album,
+	})
+}
+
 // SendButtons envia uma mensagem com botões
 func (h *MessageHandler) SendButtons(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -285,7 +394,7 @@ func (h *MessageHandler) SendButtons(c *gin.Context) {
 	result, err := h.submitWorkerTask(userIDStr, worker.CmdSendButtons, payload)
 	if err != nil {
 		logger.Error("Falha ao enviar mensagem com botões", "error", err, "user_id", userIDStr, "to", req.To)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao enviar mensagem com botões", "details": err.Error()})
+		sendErrorResponse(c, "Falha ao enviar mensagem com botões", err)
 		return
 	}
 
@@ -343,7 +452,7 @@ func (h *MessageHandler) SendList(c *gin.Context) {
 	result, err := h.submitWorkerTask(userIDStr, worker.CmdSendList, payload)
 	if err != nil {
 		logger.Error("Falha ao enviar mensagem com lista", "error", err, "user_id", userIDStr, "to", req.To)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao enviar mensagem com lista", "details": err.Error()})
+		sendErrorResponse(c, "Falha ao enviar mensagem com lista", err)
 		return
 	}
 
@@ -419,3 +528,75 @@ func (h *MessageHandler) CheckNumber(c *gin.Context) {
 		Status: "checked",
 	})
 }
+
+// CheckNumbers verifica vários números no WhatsApp de uma vez, reaproveitando consultas em cache
+func (h *MessageHandler) CheckNumbers(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req CheckNumbersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	results, err := h.sessionManager.CheckNumbers(userIDStr, req.Numbers)
+	if err != nil {
+		logger.Error("Falha ao verificar números", "error", err, "user_id", userIDStr, "count", len(req.Numbers))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao verificar números", "details": err.Error()})
+		return
+	}
+
+	registered, cached, invalid := 0, 0, 0
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			invalid++
+		case result.Exists:
+			registered++
+		}
+		if result.Cached {
+			cached++
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"summary": gin.H{
+			"total":      len(results),
+			"registered": registered,
+			"invalid":    invalid,
+			"cached":     cached,
+		},
+	})
+}
+
+// ClearNumberCache remove as consultas de números em cache da sessão
+func (h *MessageHandler) ClearNumberCache(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	removed, err := h.sessionManager.ClearNumberCache(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao limpar cache de números", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao limpar cache de números", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"removed": removed,
+		"message": "Cache de números limpo com sucesso",
+	})
+}
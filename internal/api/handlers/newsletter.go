@@ -4,6 +4,7 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -118,6 +119,44 @@ type UpdateNewsletterDescriptionRequest struct {
 // ListChannelsRequest representa uma requisição para listar canais
 type ListChannelsRequest struct{}
 
+// NewsletterPostRequest representa uma publicação em um canal administrado pela sessão
+type NewsletterPostRequest struct {
+	JID             string   `json:"jid" binding:"required"`
+	Type            string   `json:"type" binding:"required,oneof=text image video poll"`
+	Text            string   `json:"text" binding:"required_if=Type text"`
+	MediaURL        string   `json:"media_url" binding:"required_if=Type image,required_if=Type video"`
+	Caption         string   `json:"caption"`
+	PollName        string   `json:"poll_name" binding:"required_if=Type poll"`
+	PollOptions     []string `json:"poll_options" binding:"omitempty,min=2,max=12"`
+	SelectableCount int      `json:"selectable_count"`
+}
+
+// EditNewsletterPostRequest representa a edição do texto de uma publicação
+type EditNewsletterPostRequest struct {
+	JID       string `json:"jid" binding:"required"`
+	MessageID string `json:"message_id" binding:"required"`
+	Text      string `json:"text" binding:"required"`
+}
+
+// DeleteNewsletterPostRequest representa a exclusão de uma publicação
+type DeleteNewsletterPostRequest struct {
+	JID       string `json:"jid" binding:"required"`
+	MessageID string `json:"message_id" binding:"required"`
+}
+
+// InviteNewsletterAdminRequest representa o convite de um usuário para administrar o canal
+type InviteNewsletterAdminRequest struct {
+	JID     string `json:"jid" binding:"required"`
+	User    string `json:"user" binding:"required"`
+	Caption string `json:"caption"`
+}
+
+// NewsletterAdminRequest representa uma operação de administração sobre um usuário do canal
+type NewsletterAdminRequest struct {
+	JID  string `json:"jid" binding:"required"`
+	User string `json:"user" binding:"required"`
+}
+
 // CreateChannel cria um novo canal do WhatsApp
 func (h *NewsletterHandler) CreateChannel(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -634,3 +673,419 @@ func (h *NewsletterHandler) UpdateNewsletterDescription(c *gin.Context) {
 		"data":    result,
 	})
 }
+
+// PublishNewsletterPost publica texto, imagem, vídeo ou enquete em um canal
+func (h *NewsletterHandler) PublishNewsletterPost(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req NewsletterPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.NewsletterPostPayload{
+		NewsletterJID:   req.JID,
+		Type:            req.Type,
+		Text:            req.Text,
+		MediaURL:        req.MediaURL,
+		Caption:         req.Caption,
+		PollName:        req.PollName,
+		PollOptions:     req.PollOptions,
+		SelectableCount: req.SelectableCount,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdPublishNewsletterPost, payload)
+	if err != nil {
+		logger.Error("Falha ao publicar na newsletter",
+			"error", err,
+			"user_id", userIDStr,
+			"newsletter_jid", req.JID,
+			"type", req.Type)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao publicar na newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Publicação enviada com sucesso",
+		"data":    result,
+	})
+}
+
+// EditNewsletterPost edita o texto de uma publicação do canal
+func (h *NewsletterHandler) EditNewsletterPost(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req EditNewsletterPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.EditNewsletterPostPayload{
+		NewsletterJID: req.JID,
+		MessageID:     req.MessageID,
+		Text:          req.Text,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdEditNewsletterPost, payload)
+	if err != nil {
+		logger.Error("Falha ao editar publicação da newsletter",
+			"error", err,
+			"user_id", userIDStr,
+			"newsletter_jid", req.JID,
+			"message_id", req.MessageID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao editar publicação da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Publicação editada com sucesso",
+		"data":    result,
+	})
+}
+
+// DeleteNewsletterPost apaga uma publicação do canal
+func (h *NewsletterHandler) DeleteNewsletterPost(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req DeleteNewsletterPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.DeleteNewsletterPostPayload{
+		NewsletterJID: req.JID,
+		MessageID:     req.MessageID,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdDeleteNewsletterPost, payload)
+	if err != nil {
+		logger.Error("Falha ao apagar publicação da newsletter",
+			"error", err,
+			"user_id", userIDStr,
+			"newsletter_jid", req.JID,
+			"message_id", req.MessageID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao apagar publicação da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Publicação apagada com sucesso",
+	})
+}
+
+// GetNewsletterPosts lista as publicações do canal com visualizações e reações
+func (h *NewsletterHandler) GetNewsletterPosts(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	jid := c.Query("jid")
+	if jid == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "jid query parameter is required"})
+		return
+	}
+
+	count, _ := strconv.Atoi(c.DefaultQuery("count", "0"))
+	before, _ := strconv.Atoi(c.DefaultQuery("before", "0"))
+
+	payload := worker.NewsletterPostsPayload{
+		JID:    jid,
+		Count:  count,
+		Before: before,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetNewsletterPosts, payload)
+	if err != nil {
+		logger.Error("Falha ao obter publicações da newsletter", "error", err, "user_id", userIDStr, "jid", jid)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter publicações da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetNewsletterPostUpdates retorna os contadores atualizados das publicações do canal.
+// since aceita um timestamp Unix em segundos.
+func (h *NewsletterHandler) GetNewsletterPostUpdates(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	jid := c.Query("jid")
+	if jid == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "jid query parameter is required"})
+		return
+	}
+
+	var since time.Time
+	if sinceStr := c.Query("since"); sinceStr != "" {
+		sinceUnix, err := strconv.ParseInt(sinceStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "since deve ser um timestamp Unix em segundos"})
+			return
+		}
+		since = time.Unix(sinceUnix, 0)
+	}
+
+	count, _ := strconv.Atoi(c.DefaultQuery("count", "0"))
+	after, _ := strconv.Atoi(c.DefaultQuery("after", "0"))
+
+	payload := worker.NewsletterPostUpdatesPayload{
+		JID:   jid,
+		Count: count,
+		Since: since,
+		After: after,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetNewsletterPostUpdates, payload)
+	if err != nil {
+		logger.Error("Falha ao obter atualizações da newsletter", "error", err, "user_id", userIDStr, "jid", jid)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter atualizações da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// SubscribeNewsletterLiveUpdates inscreve a sessão nas atualizações ao vivo do canal.
+// Enquanto a inscrição durar, as mudanças de contadores são emitidas como eventos newsletter.post.updated.
+func (h *NewsletterHandler) SubscribeNewsletterLiveUpdates(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req ChannelJIDRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.ChannelJIDPayload{
+		JID: req.JID,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdSubscribeNewsletterUpdates, payload)
+	if err != nil {
+		logger.Error("Falha ao inscrever nas atualizações da newsletter", "error", err, "user_id", userIDStr, "jid", req.JID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao inscrever nas atualizações da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Inscrição nas atualizações do canal realizada com sucesso",
+		"data":    result,
+	})
+}
+
+// InviteNewsletterAdmin convida um usuário para administrar o canal
+func (h *NewsletterHandler) InviteNewsletterAdmin(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req InviteNewsletterAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.NewsletterAdminPayload{
+		JID:     req.JID,
+		User:    req.User,
+		Caption: req.Caption,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdInviteNewsletterAdmin, payload)
+	if err != nil {
+		logger.Error("Falha ao convidar administrador da newsletter", "error", err, "user_id", userIDStr, "jid", req.JID, "user", req.User)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao convidar administrador da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Convite de administrador enviado com sucesso",
+		"data":    result,
+	})
+}
+
+// ListNewsletterAdmins lista o dono e os administradores do canal
+func (h *NewsletterHandler) ListNewsletterAdmins(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	jid := c.Query("jid")
+	if jid == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "jid query parameter is required"})
+		return
+	}
+
+	payload := worker.ChannelJIDPayload{
+		JID: jid,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdListNewsletterAdmins, payload)
+	if err != nil {
+		logger.Error("Falha ao listar administradores da newsletter", "error", err, "user_id", userIDStr, "jid", jid)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar administradores da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RevokeNewsletterAdmin remove os direitos de administrador de um usuário do canal
+func (h *NewsletterHandler) RevokeNewsletterAdmin(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req NewsletterAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.NewsletterAdminPayload{
+		JID:  req.JID,
+		User: req.User,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdRevokeNewsletterAdmin, payload)
+	if err != nil {
+		logger.Error("Falha ao remover administrador da newsletter", "error", err, "user_id", userIDStr, "jid", req.JID, "user", req.User)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao remover administrador da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Administrador removido com sucesso",
+	})
+}
+
+// TransferNewsletterOwnership transfere a propriedade do canal para outro administrador
+func (h *NewsletterHandler) TransferNewsletterOwnership(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req NewsletterAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.NewsletterAdminPayload{
+		JID:  req.JID,
+		User: req.User,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdTransferNewsletterOwnership, payload)
+	if err != nil {
+		logger.Error("Falha ao transferir propriedade da newsletter", "error", err, "user_id", userIDStr, "jid", req.JID, "user", req.User)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao transferir propriedade da newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Propriedade do canal transferida com sucesso",
+	})
+}
+
+// DeleteNewsletter apaga definitivamente o canal
+func (h *NewsletterHandler) DeleteNewsletter(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req ChannelJIDRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.ChannelJIDPayload{
+		JID: req.JID,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdDeleteNewsletter, payload)
+	if err != nil {
+		logger.Error("Falha ao apagar newsletter", "error", err, "user_id", userIDStr, "jid", req.JID)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao apagar newsletter", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Canal apagado com sucesso",
+	})
+}
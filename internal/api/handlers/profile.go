@@ -0,0 +1,331 @@
+// internal/api/handlers/profile.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// ProfileHandler gerencia endpoints do perfil da conta e de contatos
+type ProfileHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewProfileHandler cria um novo handler de perfil
+func NewProfileHandler(sm *whatsapp.SessionManager) *ProfileHandler {
+	return &ProfileHandler{
+		sessionManager: sm,
+	}
+}
+
+// SetProfilePictureRequest representa a requisição para alterar a foto de perfil
+type SetProfilePictureRequest struct {
+	ImageURL string `json:"image_url" binding:"required,url"`
+}
+
+// SetPushNameRequest representa a requisição para alterar o nome exibido da conta
+type SetPushNameRequest struct {
+	Name string `json:"name" binding:"required,max=25"`
+}
+
+// SetProfileAboutRequest representa a requisição para alterar o recado da conta
+type SetProfileAboutRequest struct {
+	About string `json:"about" binding:"max=139"`
+}
+
+// UpdatePrivacySettingsRequest representa as configurações de privacidade a alterar; campos omitidos ficam como estão
+type UpdatePrivacySettingsRequest struct {
+	LastSeen     string `json:"last_seen" binding:"omitempty,oneof=all contacts contact_blacklist none"`
+	Online       string `json:"online" binding:"omitempty,oneof=all match_last_seen"`
+	ProfilePhoto string `json:"profile_photo" binding:"omitempty,oneof=all contacts contact_blacklist none"`
+	About        string `json:"about" binding:"omitempty,oneof=all contacts contact_blacklist none"`
+	GroupAdd     string `json:"group_add" binding:"omitempty,oneof=all contacts contact_blacklist none"`
+	ReadReceipts string `json:"read_receipts" binding:"omitempty,oneof=all none"`
+	CallAdd      string `json:"call_add" binding:"omitempty,oneof=all known"`
+}
+
+// submitWorkerTask submits a task to the worker system and waits for response with proper error handling
+func (h *ProfileHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	// Get coordinator and worker pool
+	coordinator := h.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	// Ensure worker exists for user
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		logger.Debug("Creating worker for user", "user_id", userID)
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+
+		// Give worker a moment to initialize
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Create response channel with proper buffering
+	responseChan := make(chan worker.CommandResponse, 1)
+
+	// Create task
+	task := worker.Task{
+		ID:       fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:     taskType,
+		UserID:   userID,
+		Priority: worker.NormalPriority,
+		Payload:  payload,
+		Response: responseChan,
+		Created:  time.Now(),
+	}
+
+	// Submit task to worker pool
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for worker response")
+	}
+}
+
+// GetProfile retorna o nome, o recado e a foto da própria conta
+func (h *ProfileHandler) GetProfile(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetOwnProfile, nil)
+	if err != nil {
+		logger.Error("Falha ao obter perfil", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter perfil", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// SetProfilePicture altera a foto de perfil da conta a partir de uma URL
+func (h *ProfileHandler) SetProfilePicture(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetProfilePictureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.SetProfilePicturePayload{
+		ImageURL: req.ImageURL,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdSetProfilePicture, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar foto de perfil", "error", err, "user_id", userIDStr, "image_url", req.ImageURL)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar foto de perfil", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Foto de perfil alterada com sucesso",
+		"data":    result,
+	})
+}
+
+// SetPushName altera o nome exibido da conta
+func (h *ProfileHandler) SetPushName(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetPushNameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.SetPushNamePayload{
+		Name: req.Name,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdSetPushName, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar nome do perfil", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar nome do perfil", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Nome do perfil alterado com sucesso",
+	})
+}
+
+// SetAbout altera o recado da conta
+func (h *ProfileHandler) SetAbout(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SetProfileAboutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.SetProfileAboutPayload{
+		About: req.About,
+	}
+
+	_, err := h.submitWorkerTask(userIDStr, worker.CmdSetProfileAbout, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar recado do perfil", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar recado do perfil", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Recado do perfil alterado com sucesso",
+	})
+}
+
+// GetContactProfile retorna a foto, o recado e o nome comercial verificado de um contato
+func (h *ProfileHandler) GetContactProfile(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	contact := c.Query("contact")
+	if contact == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "contact query parameter is required"})
+		return
+	}
+
+	// fresh=true ignora o cache e consulta o WhatsApp
+	fresh, _ := strconv.ParseBool(c.DefaultQuery("fresh", "false"))
+
+	payload := worker.ContactProfilePayload{
+		Contact: contact,
+		Fresh:   fresh,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetContactProfile, payload)
+	if err != nil {
+		logger.Error("Falha ao obter perfil do contato", "error", err, "user_id", userIDStr, "contact", contact)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter perfil do contato", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetPrivacySettings retorna as configurações de privacidade da conta
+func (h *ProfileHandler) GetPrivacySettings(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdGetPrivacySettings, nil)
+	if err != nil {
+		logger.Error("Falha ao obter configurações de privacidade", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configurações de privacidade", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// UpdatePrivacySettings altera as configurações de privacidade informadas e retorna o estado final
+func (h *ProfileHandler) UpdatePrivacySettings(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req UpdatePrivacySettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.PrivacySettingsPayload{
+		LastSeen:     req.LastSeen,
+		Online:       req.Online,
+		ProfilePhoto: req.ProfilePhoto,
+		About:        req.About,
+		GroupAdd:     req.GroupAdd,
+		ReadReceipts: req.ReadReceipts,
+		CallAdd:      req.CallAdd,
+	}
+
+	result, err := h.submitWorkerTask(userIDStr, worker.CmdUpdatePrivacySettings, payload)
+	if err != nil {
+		logger.Error("Falha ao alterar configurações de privacidade", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao alterar configurações de privacidade", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Configurações de privacidade alteradas com sucesso",
+		"data":    result,
+	})
+}
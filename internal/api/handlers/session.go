@@ -674,3 +674,145 @@ func (h *SessionHandler) LogoutSession(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "Logout realizado com sucesso"})
 }
+
+// GetRateLimit retorna os limites de envio, o aquecimento e as métricas da sessão
+func (h *SessionHandler) GetRateLimit(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	status, err := h.sessionManager.GetRateLimit(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter limites de envio", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter limites de envio", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+	})
+}
+
+// SetRateLimit atualiza os limites de envio da sessão.
+// Campos omitidos mantêm o valor atual.
+func (h *SessionHandler) SetRateLimit(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	current, err := h.sessionManager.GetRateLimit(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter limites de envio", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter limites de envio", "details": err.Error()})
+		return
+	}
+
+	config := current.Config
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	status, err := h.sessionManager.SetRateLimit(userIDStr, config)
+	if err != nil {
+		logger.Error("Falha ao atualizar limites de envio", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar limites de envio", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+		"message": "Limites de envio atualizados com sucesso",
+	})
+}
+
+// ResetRateLimit restaura os limites de envio padrão da sessão
+func (h *SessionHandler) ResetRateLimit(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	status, err := h.sessionManager.ResetRateLimit(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao restaurar limites de envio", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao restaurar limites de envio", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+		"message": "Limites de envio restaurados para o padrão",
+	})
+}
+
+// PhoneRegionRequest representa a requisição para definir a região padrão de telefone
+type PhoneRegionRequest struct {
+	Region string `json:"region" binding:"required"`
+}
+
+// GetPhoneRegion retorna a região usada para números sem código do país
+func (h *SessionHandler) GetPhoneRegion(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	status, err := h.sessionManager.GetPhoneRegion(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter região padrão", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter região padrão", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+	})
+}
+
+// SetPhoneRegion define a região usada para números sem código do país (ex.: "BR", "MX", "AR")
+func (h *SessionHandler) SetPhoneRegion(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req PhoneRegionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	status, err := h.sessionManager.SetPhoneRegion(userIDStr, req.Region)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar região padrão", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+		"message": "Região padrão atualizada com sucesso",
+	})
+}
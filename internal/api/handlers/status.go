@@ -0,0 +1,218 @@
+// internal/api/handlers/status.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// StatusHandler gerencia endpoints de status (stories) do WhatsApp
+type StatusHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// NewStatusHandler cria um novo handler de status
+func NewStatusHandler(sm *whatsapp.SessionManager) *StatusHandler {
+	return &StatusHandler{
+		sessionManager: sm,
+	}
+}
+
+// PostTextStatusRequest representa a requisição para publicar um status de texto
+type PostTextStatusRequest struct {
+	Text            string   `json:"text" binding:"required"`
+	BackgroundColor string   `json:"background_color"`
+	Font            string   `json:"font"`
+	Audience        []string `json:"audience"`
+}
+
+// PostMediaStatusRequest representa a requisição para publicar um status de imagem ou vídeo
+type PostMediaStatusRequest struct {
+	MediaURL  string   `json:"media_url" binding:"required"`
+	MediaType string   `json:"media_type" binding:"required,oneof=image video"`
+	Caption   string   `json:"caption"`
+	Audience  []string `json:"audience"`
+}
+
+// submitWorkerTask submits a task to the worker system and waits for response with proper error handling
+func (h *StatusHandler) submitWorkerTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	// Get coordinator and worker pool
+	coordinator := h.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	// Ensure worker exists for user
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		logger.Debug("Creating worker for user", "user_id", userID)
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+
+		// Give worker a moment to initialize
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Create response channel with proper buffering
+	responseChan := make(chan worker.CommandResponse, 1)
+
+	// Create task
+	task := worker.Task{
+		ID:       fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:     taskType,
+		UserID:   userID,
+		Priority: worker.NormalPriority,
+		Payload:  payload,
+		Response: responseChan,
+		Created:  time.Now(),
+	}
+
+	// Submit task to worker pool
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for worker response")
+	}
+}
+
+// PostTextStatus publica um status de texto
+func (h *StatusHandler) PostTextStatus(c *gin.Context) {
+	var req PostTextStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.TextStatusPayload{
+		Text:            req.Text,
+		BackgroundColor: req.BackgroundColor,
+		Font:            req.Font,
+		Audience:        req.Audience,
+	}
+
+	h.submitStatus(c, worker.CmdPostTextStatus, payload)
+}
+
+// PostMediaStatus publica um status de imagem ou vídeo
+func (h *StatusHandler) PostMediaStatus(c *gin.Context) {
+	var req PostMediaStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	payload := worker.MediaStatusPayload{
+		MediaURL:  req.MediaURL,
+		MediaType: req.MediaType,
+		Caption:   req.Caption,
+		Audience:  req.Audience,
+	}
+
+	h.submitStatus(c, worker.CmdPostMediaStatus, payload)
+}
+
+// submitStatus envia a publicação do status ao worker e responde com o ID da mensagem
+func (h *StatusHandler) submitStatus(c *gin.Context, command worker.CommandType, payload interface{}) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	result, err := h.submitWorkerTask(userIDStr, command, payload)
+	if err != nil {
+		logger.Error("Falha ao publicar status", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao publicar status", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"message_id": result,
+		},
+		"message": "Status publicado com sucesso",
+	})
+}
+
+// GetConfig retorna a configuração de download de mídia dos status recebidos
+func (h *StatusHandler) GetConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetStatusConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de status", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de status", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetConfig atualiza a configuração de download de mídia dos status recebidos.
+// Campos omitidos mantêm o valor atual.
+func (h *StatusHandler) SetConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetStatusConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de status", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de status", "details": err.Error()})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	config, err = h.sessionManager.SetStatusConfig(userIDStr, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar configuração de status", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+		"message": "Configuração de status atualizada com sucesso",
+	})
+}
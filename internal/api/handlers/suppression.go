@@ -0,0 +1,328 @@
+// internal/api/handlers/suppression.go
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// SuppressionHandler gerencia endpoints da lista de supressão (opt-out)
+type SuppressionHandler struct {
+	sessionManager *whatsapp.SessionManager
+}
+
+// SuppressionRequest representa a requisição para adicionar ou remover um número
+type SuppressionRequest struct {
+	Phone  string `json:"phone" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+// SuppressionImportRequest representa a importação em massa de números via JSON
+type SuppressionImportRequest struct {
+	Phones []string `json:"phones" binding:"required,min=1"`
+	Reason string   `json:"reason"`
+}
+
+// NewSuppressionHandler cria um novo handler da lista de supressão
+func NewSuppressionHandler(sm *whatsapp.SessionManager) *SuppressionHandler {
+	return &SuppressionHandler{
+		sessionManager: sm,
+	}
+}
+
+// ListSuppressions lista os números com opt-out da sessão
+func (h *SuppressionHandler) ListSuppressions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	entries, total, err := h.sessionManager.ListSuppressions(userIDStr, limit, offset)
+	if err != nil {
+		logger.Error("Falha ao listar supressões", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao listar supressões", "details": err.Error()})
+		return
+	}
+
+	if entries == nil {
+		entries = []storage.SuppressionEntry{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    entries,
+		"total":   total,
+	})
+}
+
+// CheckSuppression verifica se um número está na lista de supressão
+func (h *SuppressionHandler) CheckSuppression(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	phone := c.Query("phone")
+	if phone == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "phone query parameter is required"})
+		return
+	}
+
+	entry, err := h.sessionManager.GetSuppression(userIDStr, phone)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao verificar supressão", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"suppressed": entry != nil,
+		"data":       entry,
+	})
+}
+
+// AddSuppression adiciona um número à lista de supressão
+func (h *SuppressionHandler) AddSuppression(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SuppressionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	entry, added, err := h.sessionManager.AddSuppression(userIDStr, req.Phone, req.Reason)
+	if err != nil {
+		logger.Error("Falha ao adicionar supressão", "error", err, "user_id", userIDStr, "phone", req.Phone)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao adicionar supressão", "details": err.Error()})
+		return
+	}
+
+	message := "Número adicionado à lista de supressão"
+	if !added {
+		message = "Número já estava na lista de supressão"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    entry,
+		"message": message,
+	})
+}
+
+// RemoveSuppression remove um número da lista de supressão
+func (h *SuppressionHandler) RemoveSuppression(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SuppressionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	removed, err := h.sessionManager.RemoveSuppression(userIDStr, req.Phone)
+	if err != nil {
+		logger.Error("Falha ao remover supressão", "error", err, "user_id", userIDStr, "phone", req.Phone)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao remover supressão", "details": err.Error()})
+		return
+	}
+
+	if !removed {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Número não está na lista de supressão"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Número removido da lista de supressão",
+	})
+}
+
+// ImportSuppressions importa números para a lista de supressão.
+// Aceita JSON com o campo "phones" ou multipart/form-data com o arquivo CSV "file".
+func (h *SuppressionHandler) ImportSuppressions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	var req SuppressionImportRequest
+	if fileHeader, err := c.FormFile("file"); err == nil {
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao ler arquivo", "details": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		req.Phones, err = whatsapp.ParseSuppressionCSV(file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo CSV inválido", "details": err.Error()})
+			return
+		}
+		req.Reason = c.PostForm("reason")
+
+		if len(req.Phones) == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo CSV sem números"})
+			return
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	result, err := h.sessionManager.ImportSuppressions(userIDStr, req.Phones, req.Reason)
+	if err != nil {
+		logger.Error("Falha ao importar supressões", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao importar supressões", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": "Importação concluída",
+	})
+}
+
+// ExportSuppressions exporta a lista de supressão completa em CSV ou JSON (?format=csv|json)
+func (h *SuppressionHandler) ExportSuppressions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	format := c.DefaultQuery("format", "csv")
+	if format != "csv" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format deve ser csv ou json"})
+		return
+	}
+
+	entries, _, err := h.sessionManager.ListSuppressions(userIDStr, 0, 0)
+	if err != nil {
+		logger.Error("Falha ao exportar supressões", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao exportar supressões", "details": err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("suppression_%s", time.Now().Format("20060102_150405"))
+
+	if format == "json" {
+		if entries == nil {
+			entries = []storage.SuppressionEntry{}
+		}
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", filename))
+		c.JSON(http.StatusOK, entries)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	writer.Write([]string{"phone", "reason", "source", "created_at"})
+	for _, entry := range entries {
+		writer.Write([]string{entry.Phone, entry.Reason, entry.Source, entry.CreatedAt.UTC().Format(time.RFC3339)})
+	}
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		logger.Error("Falha ao escrever CSV de supressões", "error", err, "user_id", userIDStr)
+	}
+}
+
+// GetOptOutConfig retorna as palavras-chave e a resposta automática de opt-out
+func (h *SuppressionHandler) GetOptOutConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetOptOutConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de opt-out", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de opt-out", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetOptOutConfig atualiza a configuração de opt-out.
+// Campos omitidos mantêm o valor atual.
+func (h *SuppressionHandler) SetOptOutConfig(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	userIDStr := userID.(string)
+
+	config, err := h.sessionManager.GetOptOutConfig(userIDStr)
+	if err != nil {
+		logger.Error("Falha ao obter configuração de opt-out", "error", err, "user_id", userIDStr)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Falha ao obter configuração de opt-out", "details": err.Error()})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&config); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos", "details": err.Error()})
+		return
+	}
+
+	config, err = h.sessionManager.SetOptOutConfig(userIDStr, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Falha ao atualizar configuração de opt-out", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    config,
+		"message": "Configuração de opt-out atualizada com sucesso",
+	})
+}
@@ -45,11 +45,22 @@ func (h *WebhookHandler) Configure(c *gin.Context) {
 
 	// Lista de eventos válidos
 	validEvents := map[string]bool{
-		"message":      true,
-		"connected":    true,
-		"disconnected": true,
-		"qr":           true,
-		"logged_out":   true,
+		"message":               true,
+		"connected":             true,
+		"disconnected":          true,
+		"qr":                    true,
+		"logged_out":            true,
+		"call.offer":            true,
+		"call.accepted":         true,
+		"call.rejected":         true,
+		"call.terminated":       true,
+		"call.auto_rejected":    true,
+		"label.updated":         true,
+		"label.deleted":         true,
+		"label.chat.updated":    true,
+		"label.message.updated": true,
+		"status.posted":         true,
+		"status.media":          true,
 	}
 
 	// Verificar se os eventos são válidos
@@ -18,6 +18,17 @@ func SetupRoutes(
 	groupHandler *handlers.GroupHandler,
 	newsletterHandler *handlers.NewsletterHandler,
 	communityHandler *handlers.CommunityHandler,
+	campaignHandler *handlers.CampaignHandler,
+	suppressionHandler *handlers.SuppressionHandler,
+	exportHandler *handlers.ExportHandler,
+	chatHandler *handlers.ChatHandler,
+	profileHandler *handlers.ProfileHandler,
+	blocklistHandler *handlers.BlocklistHandler,
+	callHandler *handlers.CallHandler,
+	historyHandler *handlers.HistoryHandler,
+	contactHandler *handlers.ContactHandler,
+	labelHandler *handlers.LabelHandler,
+	statusHandler *handlers.StatusHandler,
 	authHandler *handlers.AuthHandler,
 	authMiddleware *middlewares.AuthMiddleware,
 ) {
@@ -47,6 +58,11 @@ func SetupRoutes(
 		session.POST("/connect", sessionHandler.ConnectSession)
 		session.POST("/disconnect", sessionHandler.DisconnectSession)
 		session.DELETE("/", sessionHandler.DeleteSession)
+		session.GET("/rate-limit", sessionHandler.GetRateLimit)
+		session.POST("/rate-limit", sessionHandler.SetRateLimit)
+		session.DELETE("/rate-limit", sessionHandler.ResetRateLimit)
+		session.GET("/phone-region", sessionHandler.GetPhoneRegion)
+		session.POST("/phone-region", sessionHandler.SetPhoneRegion)
 	}
 
 	// Rotas admin (requerem chave especial)
@@ -68,10 +84,13 @@ func SetupRoutes(
 	{
 		message.POST("/text", messageHandler.SendText)
 		message.POST("/media", messageHandler.SendMedia)
+		message.POST("/album", messageHandler.SendAlbum)
 		message.POST("/buttons", messageHandler.SendButtons)
 		message.POST("/list", messageHandler.SendList)
 		message.POST("/template", messageHandler.SendTemplate)
 		message.POST("/check-number", messageHandler.CheckNumber)
+		message.POST("/check-numbers", messageHandler.CheckNumbers)
+		message.DELETE("/number-cache", messageHandler.ClearNumberCache)
 	}
 
 	// Rotas de grupos
@@ -85,17 +104,110 @@ func SetupRoutes(
 		group.POST("/participants/remove", groupHandler.RemoveParticipants)
 		group.POST("/participants/promote", groupHandler.PromoteParticipants)
 		group.POST("/participants/demote", groupHandler.DemoteParticipants)
+		group.GET("/participants/export", exportHandler.ExportGroupParticipants)
+		group.GET("/participants/export/status", exportHandler.GetGroupParticipantsExport)
+		group.GET("/participants/export/download", exportHandler.DownloadGroupParticipantsExport)
 		group.POST("/update/name", groupHandler.UpdateGroupName)
 		group.POST("/update/topic", groupHandler.UpdateGroupTopic)
 		group.POST("/update/picture", groupHandler.UpdateGroupPicture)
 		group.POST("/leave", groupHandler.LeaveGroup)
 		group.POST("/join", groupHandler.JoinGroupWithLink)
 		group.GET("/invite-link", groupHandler.GetGroupInviteLink)
+		group.GET("/invite-info", groupHandler.GetGroupInviteInfo)
 		group.POST("/invite-link/revoke", groupHandler.RevokeGroupInviteLink)
 		group.POST("/set-locked", groupHandler.SetGroupLocked)
 		group.POST("/set-announce", groupHandler.SetGroupAnnounce)
 		group.POST("/set-join-approval", groupHandler.SetGroupJoinApprovalMode)
 		group.POST("/set-member-add-mode", groupHandler.SetGroupMemberAddMode)
+		group.POST("/set-disappearing-timer", groupHandler.SetGroupDisappearingTimer)
+		group.GET("/join-requests", groupHandler.GetGroupJoinRequests)
+		group.POST("/join-requests/approve", groupHandler.ApproveGroupJoinRequests)
+		group.POST("/join-requests/reject", groupHandler.RejectGroupJoinRequests)
+	}
+
+	// Rotas de conversas
+	chats := v1.Group("/chats")
+	{
+		chats.GET("", chatHandler.ListChats)
+		chats.POST("/archive", chatHandler.ArchiveChat)
+		chats.POST("/pin", chatHandler.PinChat)
+		chats.POST("/mute", chatHandler.MuteChat)
+		chats.POST("/unread", chatHandler.MarkChatUnread)
+		chats.POST("/clear", chatHandler.ClearChat)
+		chats.POST("/delete", chatHandler.DeleteChat)
+		chats.POST("/disappearing-timer", chatHandler.SetChatDisappearingTimer)
+		chats.POST("/default-disappearing-timer", chatHandler.SetDefaultDisappearingTimer)
+	}
+
+	// Rotas de perfil
+	profile := v1.Group("/profile")
+	{
+		profile.GET("", profileHandler.GetProfile)
+		profile.POST("/picture", profileHandler.SetProfilePicture)
+		profile.POST("/name", profileHandler.SetPushName)
+		profile.POST("/about", profileHandler.SetAbout)
+		profile.GET("/contact", profileHandler.GetContactProfile)
+	}
+
+	// Rotas de privacidade
+	privacy := v1.Group("/privacy")
+	{
+		privacy.GET("", profileHandler.GetPrivacySettings)
+		privacy.POST("", profileHandler.UpdatePrivacySettings)
+	}
+
+	// Rotas de bloqueio de contatos
+	blocklist := v1.Group("/blocklist")
+	{
+		blocklist.GET("", blocklistHandler.GetBlocklist)
+		blocklist.POST("/block", blocklistHandler.BlockContact)
+		blocklist.POST("/unblock", blocklistHandler.UnblockContact)
+		blocklist.GET("/auto-block", blocklistHandler.GetAutoBlockConfig)
+		blocklist.POST("/auto-block", blocklistHandler.SetAutoBlockConfig)
+	}
+
+	// Rotas de chamadas
+	calls := v1.Group("/calls")
+	{
+		calls.GET("/auto-reject", callHandler.GetRejectConfig)
+		calls.POST("/auto-reject", callHandler.SetRejectConfig)
+	}
+
+	// Rotas de histórico sincronizado
+	history := v1.Group("/history")
+	{
+		history.GET("/config", historyHandler.GetSyncConfig)
+		history.POST("/config", historyHandler.SetSyncConfig)
+		history.GET("/messages", historyHandler.ListMessages)
+	}
+
+	// Rotas de contatos
+	contacts := v1.Group("/contacts")
+	{
+		contacts.GET("", contactHandler.ListContacts)
+		contacts.GET("/info", contactHandler.GetContact)
+		contacts.POST("/import", contactHandler.ImportContacts)
+	}
+
+	// Rotas de etiquetas do WhatsApp Business
+	labels := v1.Group("/labels")
+	{
+		labels.GET("", labelHandler.ListLabels)
+		labels.GET("/chats", labelHandler.ListLabelChats)
+		labels.POST("", labelHandler.CreateLabel)
+		labels.POST("/edit", labelHandler.EditLabel)
+		labels.POST("/delete", labelHandler.DeleteLabel)
+		labels.POST("/chat", labelHandler.LabelChat)
+		labels.POST("/message", labelHandler.LabelMessage)
+	}
+
+	// Rotas de status
+	status := v1.Group("/status")
+	{
+		status.POST("/text", statusHandler.PostTextStatus)
+		status.POST("/media", statusHandler.PostMediaStatus)
+		status.GET("/config", statusHandler.GetConfig)
+		status.POST("/config", statusHandler.SetConfig)
 	}
 
 	// Rotas de newsletter
@@ -112,6 +224,17 @@ func SetupRoutes(
 		newsletter.POST("/update/picture", newsletterHandler.UpdateNewsletterPicture)
 		newsletter.POST("/update/name", newsletterHandler.UpdateNewsletterName)
 		newsletter.POST("/update/description", newsletterHandler.UpdateNewsletterDescription)
+		newsletter.POST("/post", newsletterHandler.PublishNewsletterPost)
+		newsletter.POST("/post/edit", newsletterHandler.EditNewsletterPost)
+		newsletter.POST("/post/delete", newsletterHandler.DeleteNewsletterPost)
+		newsletter.GET("/posts", newsletterHandler.GetNewsletterPosts)
+		newsletter.GET("/posts/updates", newsletterHandler.GetNewsletterPostUpdates)
+		newsletter.POST("/live-updates", newsletterHandler.SubscribeNewsletterLiveUpdates)
+		newsletter.POST("/admin/invite", newsletterHandler.InviteNewsletterAdmin)
+		newsletter.GET("/admin/list", newsletterHandler.ListNewsletterAdmins)
+		newsletter.POST("/admin/revoke", newsletterHandler.RevokeNewsletterAdmin)
+		newsletter.POST("/admin/transfer-ownership", newsletterHandler.TransferNewsletterOwnership)
+		newsletter.POST("/admin/delete", newsletterHandler.DeleteNewsletter)
 	}
 
 	// Rotas de comunidade
@@ -131,6 +254,34 @@ func SetupRoutes(
 		community.GET("/invite-link", communityHandler.GetCommunityInviteLink)
 		community.POST("/invite-link/revoke", communityHandler.RevokeCommunityInviteLink)
 		community.GET("/linked-groups", communityHandler.GetCommunityLinkedGroups)
+		community.GET("/members", communityHandler.GetCommunityMembers)
+		community.POST("/announcement", communityHandler.SendCommunityAnnouncement)
+	}
+
+	// Rotas de campanhas de envio em massa
+	campaign := v1.Group("/campaign")
+	{
+		campaign.POST("/create", campaignHandler.CreateCampaign)
+		campaign.GET("/list", campaignHandler.ListCampaigns)
+		campaign.GET("/info", campaignHandler.GetCampaign)
+		campaign.GET("/results", campaignHandler.GetCampaignResults)
+		campaign.POST("/start", campaignHandler.StartCampaign)
+		campaign.POST("/pause", campaignHandler.PauseCampaign)
+		campaign.POST("/resume", campaignHandler.ResumeCampaign)
+		campaign.POST("/cancel", campaignHandler.CancelCampaign)
+	}
+
+	// Rotas da lista de supressão (opt-out)
+	suppression := v1.Group("/suppression")
+	{
+		suppression.GET("/list", suppressionHandler.ListSuppressions)
+		suppression.GET("/check", suppressionHandler.CheckSuppression)
+		suppression.POST("/add", suppressionHandler.AddSuppression)
+		suppression.POST("/remove", suppressionHandler.RemoveSuppression)
+		suppression.POST("/import", suppressionHandler.ImportSuppressions)
+		suppression.GET("/export", suppressionHandler.ExportSuppressions)
+		suppression.GET("/config", suppressionHandler.GetOptOutConfig)
+		suppression.POST("/config", suppressionHandler.SetOptOutConfig)
 	}
 
 	// Configuração de webhook
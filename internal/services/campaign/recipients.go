@@ -0,0 +1,148 @@
+// internal/services/campaign/recipients.go
+package campaign
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"yourproject/internal/storage"
+)
+
+// Recipient é um destinatário de campanha com suas variáveis de template
+type Recipient struct {
+	To        string            `json:"to"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// recipientColumns são os nomes aceitos para a coluna de destinatário no CSV
+var recipientColumns = map[string]bool{
+	"to":     true,
+	"phone":  true,
+	"number": true,
+	"jid":    true,
+}
+
+// templateVarPattern encontra variáveis no formato {{nome}}
+var templateVarPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// ParseRecipientsJSON lê uma lista de destinatários em JSON
+func ParseRecipientsJSON(r io.Reader) ([]Recipient, error) {
+	var recipients []Recipient
+	if err := json.NewDecoder(r).Decode(&recipients); err != nil {
+		return nil, fmt.Errorf("JSON de destinatários inválido: %w", err)
+	}
+	return normalizeRecipients(recipients)
+}
+
+// ParseRecipientsCSV lê uma lista de destinatários em CSV.
+// A primeira linha é o cabeçalho; a coluna to/phone/number/jid identifica o destinatário
+// e as demais colunas viram variáveis do template.
+func ParseRecipientsCSV(r io.Reader) ([]Recipient, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler cabeçalho do CSV: %w", err)
+	}
+
+	toIndex := -1
+	for i, col := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(col))
+		if toIndex == -1 && recipientColumns[header[i]] {
+			toIndex = i
+		}
+	}
+	if toIndex == -1 {
+		return nil, fmt.Errorf("CSV deve conter uma coluna to, phone, number ou jid")
+	}
+
+	var recipients []Recipient
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler linha %d do CSV: %w", line, err)
+		}
+		if toIndex >= len(record) {
+			return nil, fmt.Errorf("linha %d do CSV sem destinatário", line)
+		}
+
+		recipient := Recipient{
+			To:        record[toIndex],
+			Variables: make(map[string]string),
+		}
+		for i, value := range record {
+			if i == toIndex || i >= len(header) || header[i] == "" {
+				continue
+			}
+			recipient.Variables[header[i]] = value
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return normalizeRecipients(recipients)
+}
+
+// normalizeRecipients remove espaços, descarta linhas vazias e duplicadas
+func normalizeRecipients(recipients []Recipient) ([]Recipient, error) {
+	seen := make(map[string]bool, len(recipients))
+	result := make([]Recipient, 0, len(recipients))
+
+	for _, r := range recipients {
+		r.To = strings.TrimSpace(r.To)
+		if r.To == "" || seen[r.To] {
+			continue
+		}
+		seen[r.To] = true
+
+		// Nomes de variáveis não diferenciam maiúsculas de minúsculas
+		variables := make(map[string]string, len(r.Variables))
+		for k, v := range r.Variables {
+			variables[strings.ToLower(strings.TrimSpace(k))] = v
+		}
+		r.Variables = variables
+
+		result = append(result, r)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("nenhum destinatário válido informado")
+	}
+
+	return result, nil
+}
+
+// toStorageRecipients converte destinatários para o formato persistido
+func toStorageRecipients(recipients []Recipient) []storage.CampaignRecipient {
+	result := make([]storage.CampaignRecipient, len(recipients))
+	for i, r := range recipients {
+		result[i] = storage.CampaignRecipient{
+			Position:  i,
+			Recipient: r.To,
+			Variables: r.Variables,
+		}
+	}
+	return result
+}
+
+// RenderTemplate substitui as variáveis {{nome}} do template pelos valores do destinatário.
+// A variável {{to}} sempre está disponível; variáveis desconhecidas são substituídas por vazio.
+func RenderTemplate(template, to string, variables map[string]string) string {
+	return templateVarPattern.ReplaceAllStringFunc(template, func(match string) string {
+		name := strings.ToLower(templateVarPattern.FindStringSubmatch(match)[1])
+		if name == "to" {
+			return to
+		}
+		return variables[name]
+	})
+}
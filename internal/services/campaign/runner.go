@@ -0,0 +1,227 @@
+// internal/services/campaign/runner.go
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"yourproject/internal/services/whatsapp/messaging"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// rateLimitBackoff é a espera antes de reenviar quando o limitador da sessão recusa o envio
+const rateLimitBackoff = 15 * time.Second
+
+// run envia a campanha destinatário por destinatário respeitando taxa, jitter e horário de silêncio
+func (s *Service) run(ctx context.Context, campaign *storage.Campaign) {
+	interval := time.Minute / time.Duration(campaign.RatePerMinute)
+
+	logger.Info("Runner de campanha iniciado",
+		"user_id", campaign.UserID,
+		"campaign_id", campaign.ID,
+		"interval", interval)
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Aguardar fim do horário de silêncio
+		if wait := quietHoursWait(campaign, time.Now()); wait > 0 {
+			logger.Debug("Campanha em horário de silêncio",
+				"campaign_id", campaign.ID,
+				"resume_in", wait)
+			if !sleepContext(ctx, wait) {
+				return
+			}
+			continue
+		}
+
+		recipient, err := s.store.NextPendingRecipient(campaign.ID)
+		if err != nil {
+			logger.Error("Falha ao obter próximo destinatário", "campaign_id", campaign.ID, "error", err)
+			if !sleepContext(ctx, 10*time.Second) {
+				return
+			}
+			continue
+		}
+
+		if recipient == nil {
+			s.complete(ctx, campaign)
+			return
+		}
+
+		messageID, sendErr := s.sendToRecipient(campaign, recipient)
+
+		// Limite da sessão atingido: manter o destinatário pendente e tentar novamente
+		if errors.Is(sendErr, worker.ErrRateLimited) {
+			logger.Debug("Envio da campanha adiado pelo limitador",
+				"campaign_id", campaign.ID,
+				"recipient", recipient.Recipient)
+			if !sleepContext(ctx, rateLimitBackoff) {
+				return
+			}
+			continue
+		}
+
+		status, errMsg := RecipientSent, ""
+		if sendErr != nil {
+			status, errMsg = RecipientFailed, sendErr.Error()
+			logger.Warn("Falha ao enviar mensagem da campanha",
+				"campaign_id", campaign.ID,
+				"recipient", recipient.Recipient,
+				"error", sendErr)
+		}
+
+		if err := s.store.SaveRecipientResult(campaign.ID, recipient.Position, status, messageID, errMsg); err != nil {
+			logger.Error("Falha ao salvar resultado do destinatário",
+				"campaign_id", campaign.ID,
+				"recipient", recipient.Recipient,
+				"error", err)
+		}
+
+		// Destinatários com opt-out não recebem mensagem, então não há por que aguardar o intervalo
+		if messaging.IsSuppressedError(sendErr) {
+			continue
+		}
+
+		if !sleepContext(ctx, interval+jitter(campaign.JitterSeconds)) {
+			return
+		}
+	}
+}
+
+// sendToRecipient renderiza o template e envia a mensagem pelo worker da sessão
+func (s *Service) sendToRecipient(campaign *storage.Campaign, recipient *storage.CampaignRecipient) (string, error) {
+	var (
+		result interface{}
+		err    error
+	)
+
+	switch campaign.MessageType {
+	case "media":
+		result, err = s.submitTask(campaign.UserID, worker.CmdSendMedia, worker.SendMediaPayload{
+			To:        recipient.Recipient,
+			MediaURL:  campaign.MediaURL,
+			MediaType: campaign.MediaType,
+			Caption:   RenderTemplate(campaign.Message, recipient.Recipient, recipient.Variables),
+		})
+	default:
+		result, err = s.submitTask(campaign.UserID, worker.CmdSendText, worker.SendTextPayload{
+			To:      recipient.Recipient,
+			Message: RenderTemplate(campaign.Message, recipient.Recipient, recipient.Variables),
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	messageID, _ := result.(string)
+	return messageID, nil
+}
+
+// complete marca a campanha como concluída e publica o evento de conclusão
+func (s *Service) complete(ctx context.Context, campaign *storage.Campaign) {
+	// Não sobrescrever uma pausa ou cancelamento feito enquanto o último envio terminava
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err := s.store.UpdateCampaignStatus(campaign.ID, StatusCompleted); err != nil {
+		logger.Error("Falha ao concluir campanha", "campaign_id", campaign.ID, "error", err)
+		return
+	}
+
+	final, err := s.store.GetCampaign(campaign.UserID, campaign.ID)
+	if err != nil {
+		logger.Error("Falha ao recarregar campanha concluída", "campaign_id", campaign.ID, "error", err)
+		return
+	}
+
+	s.publishEvent(final, "campaign.completed", map[string]interface{}{
+		"started_at":   final.StartedAt,
+		"completed_at": final.CompletedAt,
+	})
+
+	logger.Info("Campanha concluída",
+		"user_id", final.UserID,
+		"campaign_id", final.ID,
+		"sent", final.Sent,
+		"failed", final.Failed)
+}
+
+// quietHoursWait retorna quanto tempo falta para o fim do horário de silêncio, ou zero fora dele
+func quietHoursWait(campaign *storage.Campaign, now time.Time) time.Duration {
+	if campaign.QuietStart == "" || campaign.QuietEnd == "" {
+		return 0
+	}
+
+	start, err := parseClock(campaign.QuietStart)
+	if err != nil {
+		return 0
+	}
+	end, err := parseClock(campaign.QuietEnd)
+	if err != nil {
+		return 0
+	}
+
+	if campaign.Timezone != "" {
+		if loc, err := time.LoadLocation(campaign.Timezone); err == nil {
+			now = now.In(loc)
+		}
+	}
+
+	current := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+
+	// Janela no mesmo dia (ex.: 12:00-14:00) ou atravessando a meia-noite (ex.: 22:00-08:00)
+	var inside bool
+	if start <= end {
+		inside = current >= start && current < end
+	} else {
+		inside = current >= start || current < end
+	}
+	if !inside {
+		return 0
+	}
+
+	wait := end - current
+	if wait <= 0 {
+		wait += 24 * time.Hour
+	}
+	return wait
+}
+
+// parseClock converte um horário HH:MM em duração desde a meia-noite
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("horário inválido %q, use o formato HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// jitter retorna um atraso aleatório entre zero e o máximo informado
+func jitter(maxSeconds int) time.Duration {
+	if maxSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(maxSeconds) * int64(time.Second)))
+}
+
+// sleepContext aguarda a duração informada ou o cancelamento do contexto
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,406 @@
+// internal/services/campaign/service.go
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"yourproject/internal/services/rabbitmq"
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// Status possíveis de uma campanha
+const (
+	StatusDraft     = "draft"
+	StatusRunning   = "running"
+	StatusPaused    = "paused"
+	StatusCancelled = "cancelled"
+	StatusCompleted = "completed"
+)
+
+// Status possíveis de um destinatário
+const (
+	RecipientPending = "pending"
+	RecipientSent    = "sent"
+	RecipientFailed  = "failed"
+)
+
+// Valores padrão de envio
+const (
+	DefaultRatePerMinute = 20
+	MaxRatePerMinute     = 120
+)
+
+// CreateRequest contém os dados para criação de uma campanha
+type CreateRequest struct {
+	Name          string      `json:"name"`
+	MessageType   string      `json:"message_type"`
+	Message       string      `json:"message"`
+	MediaURL      string      `json:"media_url"`
+	MediaType     string      `json:"media_type"`
+	RatePerMinute int         `json:"rate_per_minute"`
+	JitterSeconds int         `json:"jitter_seconds"`
+	QuietStart    string      `json:"quiet_start"`
+	QuietEnd      string      `json:"quiet_end"`
+	Timezone      string      `json:"timezone"`
+	Recipients    []Recipient `json:"recipients"`
+}
+
+// Service gerencia campanhas de envio em massa
+type Service struct {
+	store          *storage.SQLStore
+	sessionManager *whatsapp.SessionManager
+	publisher      *rabbitmq.EventPublisher
+
+	runners map[string]*runner
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+}
+
+// runner controla a goroutine de envio de uma campanha.
+// Um runner interrompido continua no mapa até terminar o envio em andamento,
+// para que uma retomada aguarde sua saída antes de enviar.
+type runner struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// NewService cria um novo serviço de campanhas
+func NewService(store *storage.SQLStore, sm *whatsapp.SessionManager, publisher *rabbitmq.EventPublisher) *Service {
+	return &Service{
+		store:          store,
+		sessionManager: sm,
+		publisher:      publisher,
+		runners:        make(map[string]*runner),
+	}
+}
+
+// Create valida e persiste uma nova campanha em rascunho
+func (s *Service) Create(userID string, req CreateRequest) (*storage.Campaign, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("nome da campanha é obrigatório")
+	}
+
+	if req.MessageType == "" {
+		req.MessageType = "text"
+	}
+	switch req.MessageType {
+	case "text":
+		if strings.TrimSpace(req.Message) == "" {
+			return nil, fmt.Errorf("mensagem é obrigatória para campanhas de texto")
+		}
+	case "media":
+		if req.MediaURL == "" || req.MediaType == "" {
+			return nil, fmt.Errorf("media_url e media_type são obrigatórios para campanhas de mídia")
+		}
+	default:
+		return nil, fmt.Errorf("tipo de mensagem não suportado: %s", req.MessageType)
+	}
+
+	if req.RatePerMinute <= 0 {
+		req.RatePerMinute = DefaultRatePerMinute
+	}
+	if req.RatePerMinute > MaxRatePerMinute {
+		return nil, fmt.Errorf("rate_per_minute não pode exceder %d", MaxRatePerMinute)
+	}
+	if req.JitterSeconds < 0 {
+		return nil, fmt.Errorf("jitter_seconds não pode ser negativo")
+	}
+
+	if (req.QuietStart == "") != (req.QuietEnd == "") {
+		return nil, fmt.Errorf("quiet_start e quiet_end devem ser informados juntos")
+	}
+	if req.QuietStart != "" {
+		if _, err := parseClock(req.QuietStart); err != nil {
+			return nil, err
+		}
+		if _, err := parseClock(req.QuietEnd); err != nil {
+			return nil, err
+		}
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, fmt.Errorf("timezone inválido: %s", req.Timezone)
+		}
+	}
+
+	recipients, err := normalizeRecipients(req.Recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign := &storage.Campaign{
+		ID:            fmt.Sprintf("camp_%d", time.Now().UnixNano()),
+		UserID:        userID,
+		Name:          req.Name,
+		Status:        StatusDraft,
+		MessageType:   req.MessageType,
+		Message:       req.Message,
+		MediaURL:      req.MediaURL,
+		MediaType:     req.MediaType,
+		RatePerMinute: req.RatePerMinute,
+		JitterSeconds: req.JitterSeconds,
+		QuietStart:    req.QuietStart,
+		QuietEnd:      req.QuietEnd,
+		Timezone:      req.Timezone,
+	}
+
+	if err := s.store.CreateCampaign(campaign, toStorageRecipients(recipients)); err != nil {
+		return nil, fmt.Errorf("falha ao salvar campanha: %w", err)
+	}
+
+	logger.Info("Campanha criada",
+		"user_id", userID,
+		"campaign_id", campaign.ID,
+		"recipients", campaign.Total)
+
+	return campaign, nil
+}
+
+// Get retorna uma campanha do usuário
+func (s *Service) Get(userID, campaignID string) (*storage.Campaign, error) {
+	return s.store.GetCampaign(userID, campaignID)
+}
+
+// List retorna todas as campanhas do usuário
+func (s *Service) List(userID string) ([]*storage.Campaign, error) {
+	return s.store.ListCampaigns(userID)
+}
+
+// Results retorna os resultados por destinatário de uma campanha
+func (s *Service) Results(userID, campaignID, status string, limit, offset int) ([]storage.CampaignRecipient, error) {
+	if _, err := s.store.GetCampaign(userID, campaignID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	return s.store.ListCampaignRecipients(campaignID, status, limit, offset)
+}
+
+// Start inicia o envio de uma campanha em rascunho
+func (s *Service) Start(userID, campaignID string) (*storage.Campaign, error) {
+	return s.transition(userID, campaignID, []string{StatusDraft}, StatusRunning, "campaign.started")
+}
+
+// Pause pausa uma campanha em execução mantendo o progresso
+func (s *Service) Pause(userID, campaignID string) (*storage.Campaign, error) {
+	return s.transition(userID, campaignID, []string{StatusRunning}, StatusPaused, "campaign.paused")
+}
+
+// Resume retoma uma campanha pausada do ponto onde parou
+func (s *Service) Resume(userID, campaignID string) (*storage.Campaign, error) {
+	return s.transition(userID, campaignID, []string{StatusPaused}, StatusRunning, "campaign.resumed")
+}
+
+// Cancel cancela definitivamente uma campanha
+func (s *Service) Cancel(userID, campaignID string) (*storage.Campaign, error) {
+	return s.transition(userID, campaignID, []string{StatusDraft, StatusRunning, StatusPaused}, StatusCancelled, "campaign.cancelled")
+}
+
+// transition valida e aplica uma mudança de status, iniciando ou parando o runner
+func (s *Service) transition(userID, campaignID string, from []string, to, eventType string) (*storage.Campaign, error) {
+	campaign, err := s.store.GetCampaign(userID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := false
+	for _, status := range from {
+		if campaign.Status == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("campanha está com status %s e não pode passar para %s", campaign.Status, to)
+	}
+
+	if to != StatusRunning {
+		s.stopRunner(campaignID)
+	}
+
+	if err := s.store.UpdateCampaignStatus(campaignID, to); err != nil {
+		return nil, err
+	}
+
+	campaign, err = s.store.GetCampaign(userID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if to == StatusRunning {
+		s.startRunner(campaign)
+	}
+
+	s.publishEvent(campaign, eventType, nil)
+
+	logger.Info("Status da campanha alterado",
+		"user_id", userID,
+		"campaign_id", campaignID,
+		"status", to)
+
+	return campaign, nil
+}
+
+// RestoreRunning retoma campanhas que estavam em execução antes de um reinício
+func (s *Service) RestoreRunning() error {
+	campaigns, err := s.store.ListCampaignsByStatus(StatusRunning)
+	if err != nil {
+		return fmt.Errorf("falha ao carregar campanhas em execução: %w", err)
+	}
+
+	for _, campaign := range campaigns {
+		logger.Info("Retomando campanha", "user_id", campaign.UserID, "campaign_id", campaign.ID)
+		s.startRunner(campaign)
+	}
+
+	return nil
+}
+
+// Stop interrompe todos os runners sem alterar o status persistido
+func (s *Service) Stop() {
+	s.mu.Lock()
+	for id, r := range s.runners {
+		r.cancel()
+		delete(s.runners, id)
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+// startRunner inicia a goroutine de envio de uma campanha.
+// Se um runner anterior da campanha ainda estiver terminando, o novo aguarda sua saída antes de enviar.
+func (s *Service) startRunner(campaign *storage.Campaign) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, exists := s.runners[campaign.ID]
+	if exists && !previous.stopped {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &runner{cancel: cancel, done: make(chan struct{})}
+	s.runners[campaign.ID] = r
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(r.done)
+		defer s.removeRunner(campaign.ID, r)
+
+		if previous != nil {
+			<-previous.done
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		s.run(ctx, campaign)
+	}()
+}
+
+// stopRunner interrompe o runner de uma campanha, se existir
+func (s *Service) stopRunner(campaignID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, exists := s.runners[campaignID]; exists {
+		r.cancel()
+		r.stopped = true
+	}
+}
+
+// removeRunner remove o runner do mapa ao terminar, se ele não tiver sido substituído por outro
+func (s *Service) removeRunner(campaignID string, r *runner) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.runners[campaignID] == r {
+		delete(s.runners, campaignID)
+	}
+}
+
+// publishEvent publica um evento de campanha no RabbitMQ
+func (s *Service) publishEvent(campaign *storage.Campaign, eventType string, extra map[string]interface{}) {
+	if s.publisher == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"campaign_id": campaign.ID,
+		"name":        campaign.Name,
+		"status":      campaign.Status,
+		"total":       campaign.Total,
+		"sent":        campaign.Sent,
+		"failed":      campaign.Failed,
+		"timestamp":   time.Now().Unix(),
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.publisher.PublishEvent(ctx, campaign.UserID, eventType, data); err != nil {
+		logger.Error("Falha ao publicar evento de campanha",
+			"campaign_id", campaign.ID,
+			"event_type", eventType,
+			"error", err)
+	}
+}
+
+// submitTask envia uma tarefa ao worker da sessão e aguarda a resposta
+func (s *Service) submitTask(userID string, taskType worker.CommandType, payload interface{}) (interface{}, error) {
+	coordinator := s.sessionManager.GetCoordinator()
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not available")
+	}
+
+	workerPool := coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil, fmt.Errorf("worker pool not available")
+	}
+
+	if _, exists := workerPool.GetWorker(userID); !exists {
+		if err := coordinator.CreateWorker(userID); err != nil {
+			return nil, fmt.Errorf("failed to create worker: %w", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	responseChan := make(chan worker.CommandResponse, 1)
+	task := worker.Task{
+		ID:         fmt.Sprintf("%s_%s_%d", taskType, userID, time.Now().UnixNano()),
+		Type:       taskType,
+		UserID:     userID,
+		Priority:   worker.LowPriority,
+		Payload:    payload,
+		Response:   responseChan,
+		Created:    time.Now(),
+		MaxRetries: 3,
+	}
+
+	if err := workerPool.SubmitTask(task); err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Data, nil
+	case <-time.After(60 * time.Second):
+		return nil, fmt.Errorf("task timeout after 60 seconds")
+	}
+}
@@ -0,0 +1,168 @@
+// internal/services/export/service.go
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// Status possíveis de uma exportação
+const (
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// KindGroupParticipants identifica a exportação de participantes de grupos
+const KindGroupParticipants = "group_participants"
+
+const (
+	// MaxInlineGroups é o máximo de grupos exportados direto na resposta; acima disso a exportação roda em segundo plano
+	MaxInlineGroups = 20
+
+	// retention é por quanto tempo os arquivos exportados ficam disponíveis para download
+	retention = 24 * time.Hour
+
+	// purgeInterval é a frequência de remoção das exportações expiradas
+	purgeInterval = time.Hour
+)
+
+// Service executa exportações em segundo plano e guarda os arquivos para download
+type Service struct {
+	store          *storage.SQLStore
+	sessionManager *whatsapp.SessionManager
+	dir            string
+}
+
+// NewService cria um novo serviço de exportação que grava os arquivos em dir
+func NewService(store *storage.SQLStore, sm *whatsapp.SessionManager, dir string) (*Service, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("falha ao criar diretório de exportações: %w", err)
+	}
+
+	// Exportações interrompidas por um reinício não têm como continuar
+	if failed, err := store.FailRunningExportJobs("exportação interrompida pelo reinício do servidor"); err != nil {
+		logger.Warn("Falha ao atualizar exportações interrompidas", "error", err)
+	} else if failed > 0 {
+		logger.Info("Exportações interrompidas marcadas como falha", "count", failed)
+	}
+
+	s := &Service{
+		store:          store,
+		sessionManager: sm,
+		dir:            dir,
+	}
+
+	go s.purgeExpired()
+
+	return s, nil
+}
+
+// StartGroupParticipants inicia a exportação dos participantes dos grupos em segundo plano
+func (s *Service) StartGroupParticipants(userID string, groupJIDs []string, format string, fresh bool) (*storage.ExportJob, error) {
+	if format != FormatCSV && format != FormatNDJSON {
+		return nil, fmt.Errorf("formato inválido: %s (deve ser csv ou ndjson)", format)
+	}
+
+	id := fmt.Sprintf("exp_%d", time.Now().UnixNano())
+	job := &storage.ExportJob{
+		ID:        id,
+		UserID:    userID,
+		Kind:      KindGroupParticipants,
+		Format:    format,
+		Status:    StatusRunning,
+		FilePath:  filepath.Join(s.dir, id+"."+format),
+		Groups:    len(groupJIDs),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.store.CreateExportJob(job); err != nil {
+		return nil, err
+	}
+
+	go s.runGroupParticipants(job, groupJIDs, fresh)
+
+	logger.Info("Exportação de participantes iniciada", "user_id", userID, "job_id", id, "groups", len(groupJIDs), "format", format)
+
+	return job, nil
+}
+
+// Get retorna uma exportação do usuário
+func (s *Service) Get(userID, jobID string) (*storage.ExportJob, error) {
+	return s.store.GetExportJob(userID, jobID)
+}
+
+// runGroupParticipants grava o arquivo da exportação e registra o resultado
+func (s *Service) runGroupParticipants(job *storage.ExportJob, groupJIDs []string, fresh bool) {
+	rows, err := s.writeGroupParticipants(job, groupJIDs, fresh)
+
+	status, errMsg := StatusCompleted, ""
+	if err != nil {
+		status, errMsg = StatusFailed, err.Error()
+		os.Remove(job.FilePath)
+		logger.Error("Falha na exportação de participantes", "user_id", job.UserID, "job_id", job.ID, "error", err)
+	} else {
+		logger.Info("Exportação de participantes concluída", "user_id", job.UserID, "job_id", job.ID, "rows", rows)
+	}
+
+	if err := s.store.FinishExportJob(job.ID, status, rows, errMsg); err != nil {
+		logger.Error("Falha ao registrar resultado da exportação", "job_id", job.ID, "error", err)
+	}
+}
+
+// writeGroupParticipants escreve os participantes de cada grupo no arquivo da exportação
+func (s *Service) writeGroupParticipants(job *storage.ExportJob, groupJIDs []string, fresh bool) (int, error) {
+	file, err := os.Create(job.FilePath)
+	if err != nil {
+		return 0, fmt.Errorf("falha ao criar arquivo: %w", err)
+	}
+	defer file.Close()
+
+	writer, err := NewParticipantWriter(file, job.Format)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.sessionManager.ExportGroupParticipants(job.UserID, groupJIDs, fresh, writer.Write); err != nil {
+		return writer.Rows(), err
+	}
+
+	if err := file.Sync(); err != nil {
+		return writer.Rows(), fmt.Errorf("falha ao gravar arquivo: %w", err)
+	}
+
+	return writer.Rows(), nil
+}
+
+// purgeExpired remove periodicamente as exportações e arquivos expirados
+func (s *Service) purgeExpired() {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		jobs, err := s.store.ListExportJobsBefore(time.Now().Add(-retention))
+		if err != nil {
+			logger.Warn("Falha ao listar exportações expiradas", "error", err)
+			continue
+		}
+
+		for _, job := range jobs {
+			if job.Status == StatusRunning {
+				continue
+			}
+			if err := os.Remove(job.FilePath); err != nil && !os.IsNotExist(err) {
+				logger.Warn("Falha ao remover arquivo de exportação", "job_id", job.ID, "error", err)
+				continue
+			}
+			if err := s.store.DeleteExportJob(job.ID); err != nil {
+				logger.Warn("Falha ao remover exportação", "job_id", job.ID, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// internal/services/export/writer.go
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"yourproject/internal/services/whatsapp/messaging"
+)
+
+// Formatos de exportação suportados
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// participantColumns são as colunas do CSV de participantes
+var participantColumns = []string{"group_jid", "group_name", "phone_number", "lid", "jid", "display_name", "role"}
+
+// ParticipantWriter escreve linhas de participantes em CSV ou NDJSON
+type ParticipantWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+	rows int
+}
+
+// NewParticipantWriter cria um writer no formato informado. O CSV já sai com o cabeçalho.
+func NewParticipantWriter(w io.Writer, format string) (*ParticipantWriter, error) {
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(participantColumns); err != nil {
+			return nil, err
+		}
+		return &ParticipantWriter{csv: writer}, nil
+	case FormatNDJSON:
+		return &ParticipantWriter{json: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("formato inválido: %s (deve ser csv ou ndjson)", format)
+	}
+}
+
+// Write escreve as linhas e as envia ao destino
+func (pw *ParticipantWriter) Write(rows []messaging.GroupParticipantRow) error {
+	for _, row := range rows {
+		if pw.csv != nil {
+			if err := pw.csv.Write([]string{row.GroupJID, row.GroupName, row.PhoneNumber, row.LID, row.JID, row.DisplayName, row.Role}); err != nil {
+				return err
+			}
+		} else if err := pw.json.Encode(row); err != nil {
+			return err
+		}
+		pw.rows++
+	}
+
+	if pw.csv != nil {
+		pw.csv.Flush()
+		return pw.csv.Error()
+	}
+	return nil
+}
+
+// Rows retorna quantas linhas foram escritas
+func (pw *ParticipantWriter) Rows() int {
+	return pw.rows
+}
+
+// ContentType retorna o tipo MIME do formato
+func ContentType(format string) string {
+	if format == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
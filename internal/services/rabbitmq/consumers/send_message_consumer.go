@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"yourproject/internal/services/rabbitmq"
 	"yourproject/internal/services/whatsapp"
+	"yourproject/internal/services/whatsapp/messaging"
 	"yourproject/internal/services/whatsapp/worker"
 	"yourproject/pkg/logger"
 )
@@ -25,6 +27,11 @@ type SendMessagePayload struct {
 			Filename *string `json:"filename,omitempty"`
 			Caption  *string `json:"caption,omitempty"`
 		} `json:"media,omitempty"`
+		Album *[]struct {
+			URL     string  `json:"url" binding:"required"`
+			Type    string  `json:"type" binding:"required"` // image, video
+			Caption *string `json:"caption,omitempty"`
+		} `json:"album,omitempty"`
 		Buttons *[]struct {
 			ButtonID   string `json:"buttonId" binding:"required"`
 			ButtonText struct {
@@ -201,6 +208,7 @@ func (smc *SendMessageConsumer) handleMessage(deliveryTag uint64, routingKey str
 		"routing_key", routingKey,
 		"has_text", payload.Message.Text != nil,
 		"has_media", payload.Message.Media != nil,
+		"has_album", payload.Message.Album != nil,
 		"has_buttons", payload.Message.Buttons != nil,
 		"has_list", payload.Message.List != nil)
 
@@ -238,6 +246,7 @@ func (smc *SendMessageConsumer) processMessageViaSessionManager(payload SendMess
 		"jid", payload.JID,
 		"has_text", msg.Text != nil,
 		"has_media", msg.Media != nil,
+		"has_album", msg.Album != nil,
 		"has_buttons", msg.Buttons != nil,
 		"has_list", msg.List != nil)
 
@@ -285,6 +294,43 @@ func (smc *SendMessageConsumer) processMessageViaSessionManager(payload SendMess
 		return nil
 	}
 
+	// Handle album message
+	if msg.Album != nil {
+		logger.Info("🖼️ HANDLER: Sending album message",
+			"session_id", payload.SessionID,
+			"jid", payload.JID,
+			"items_count", len(*msg.Album))
+
+		var items []worker.AlbumItem
+		for _, media := range *msg.Album {
+			caption := ""
+			if media.Caption != nil {
+				caption = *media.Caption
+			}
+			items = append(items, worker.AlbumItem{
+				MediaURL:  media.URL,
+				MediaType: media.Type,
+				Caption:   caption,
+			})
+		}
+
+		result, err := smc.sessionManager.SendAlbum(payload.SessionID, payload.JID, items)
+		if err != nil {
+			return fmt.Errorf("failed to send album message: %w", err)
+		}
+		// Report partially sent albums so the failed items can be retried
+		if album, ok := result.(*messaging.AlbumResult); ok && album.Failed > 0 {
+			var failures []string
+			for _, item := range album.Items {
+				if item.Error != "" {
+					failures = append(failures, fmt.Sprintf("item %d: %s", item.Index, item.Error))
+				}
+			}
+			return fmt.Errorf("failed to send %d of %d album items: %s", album.Failed, len(items), strings.Join(failures, "; "))
+		}
+		return nil
+	}
+
 	// Handle buttons message
 	if msg.Buttons != nil {
 		logger.Info("🔘 HANDLER: Sending buttons message",
@@ -368,6 +414,11 @@ func (smc *SendMessageConsumer) publishErrorEvent(sessionID, eventType string, p
 		"timestamp": time.Now().Unix(),
 	}
 
+	// Identify sends refused because the recipient opted out
+	if messaging.IsSuppressedError(err) {
+		errorEvent["code"] = messaging.ErrCodeRecipientSuppressed
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -290,6 +290,66 @@ func (qs *QueueSetup) SetupWhatsAppQueues() error {
 			name:       "whatsapp.events.group.updated",
 			routingKey: "whatsapp.events.group.invite.link.changed",
 		},
+		// Group join request events
+		{
+			name:       "whatsapp.events.group.join_request",
+			routingKey: "whatsapp.events.group.join_request.#",
+		},
+		// Campaign events
+		{
+			name:       "whatsapp.events.campaign",
+			routingKey: "whatsapp.events.campaign.*",
+		},
+		// Opt-out events
+		{
+			name:       "whatsapp.events.optout",
+			routingKey: "whatsapp.events.optout.*",
+		},
+		// Newsletter events
+		{
+			name:       "whatsapp.events.newsletter",
+			routingKey: "whatsapp.events.newsletter.#",
+		},
+		// Contact events
+		{
+			name:       "whatsapp.events.contact",
+			routingKey: "whatsapp.events.contact.#",
+		},
+		// Privacy events
+		{
+			name:       "whatsapp.events.privacy",
+			routingKey: "whatsapp.events.privacy.*",
+		},
+		// Blocklist events
+		{
+			name:       "whatsapp.events.blocklist",
+			routingKey: "whatsapp.events.blocklist.*",
+		},
+		// Call events
+		{
+			name:       "whatsapp.events.call",
+			routingKey: "whatsapp.events.call.*",
+		},
+		// History sync events
+		{
+			name:       "whatsapp.events.history",
+			routingKey: "whatsapp.events.history.#",
+		},
+		// Chat state events
+		{
+			name:       "whatsapp.events.chat",
+			routingKey: "whatsapp.events.chat.*",
+		},
+		// Business label events
+		{
+			name:       "whatsapp.events.label",
+			routingKey: "whatsapp.events.label.#",
+		},
+		// Status (stories) events
+		{
+			name:       "whatsapp.events.status",
+			routingKey: "whatsapp.events.status.*",
+		},
 	}
 
 	for _, q := range queues {
@@ -0,0 +1,222 @@
+// internal/services/whatsapp/autoblock.go
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/pkg/logger"
+)
+
+// autoBlockSettingKey is the session_settings key holding the auto-block config
+const autoBlockSettingKey = "auto_block"
+
+// autoBlockWindow is the period in which messages of a sender are counted
+const autoBlockWindow = time.Minute
+
+// AutoBlockConfig defines when unknown contacts flooding the session are blocked
+type AutoBlockConfig struct {
+	Enabled              bool `json:"enabled"`
+	MaxMessagesPerMinute int  `json:"max_messages_per_minute"`
+}
+
+// DefaultAutoBlockConfig returns the auto-block config applied to sessions without a custom one
+func DefaultAutoBlockConfig() AutoBlockConfig {
+	return AutoBlockConfig{
+		Enabled:              false,
+		MaxMessagesPerMinute: 20,
+	}
+}
+
+// floodCounter keeps the recent message times of each sender
+type floodCounter struct {
+	mu      sync.Mutex
+	senders map[string][]time.Time
+}
+
+// hit records a message and returns how many messages the sender sent within the window
+func (f *floodCounter) hit(key string, now time.Time) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.senders == nil {
+		f.senders = make(map[string][]time.Time)
+	}
+
+	recent := f.senders[key][:0]
+	for _, t := range f.senders[key] {
+		if now.Sub(t) < autoBlockWindow {
+			recent = append(recent, t)
+		}
+	}
+	recent = append(recent, now)
+	f.senders[key] = recent
+
+	return len(recent)
+}
+
+// reset forgets the messages of a sender
+func (f *floodCounter) reset(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.senders, key)
+}
+
+// prune removes senders without messages within the window
+func (f *floodCounter) prune(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for key, times := range f.senders {
+		if len(times) == 0 || now.Sub(times[len(times)-1]) >= autoBlockWindow {
+			delete(f.senders, key)
+		}
+	}
+}
+
+// GetAutoBlockConfig returns the auto-block config of a session
+func (sm *SessionManager) GetAutoBlockConfig(userID string) (AutoBlockConfig, error) {
+	value, exists, err := sm.sqlStore.GetSessionSetting(userID, autoBlockSettingKey)
+	if err != nil {
+		return AutoBlockConfig{}, err
+	}
+	if !exists {
+		return DefaultAutoBlockConfig(), nil
+	}
+
+	config := DefaultAutoBlockConfig()
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return AutoBlockConfig{}, fmt.Errorf("configuração de bloqueio automático inválida: %w", err)
+	}
+
+	return config, nil
+}
+
+// SetAutoBlockConfig validates and persists the auto-block config of a session
+func (sm *SessionManager) SetAutoBlockConfig(userID string, config AutoBlockConfig) (AutoBlockConfig, error) {
+	if config.MaxMessagesPerMinute < 1 {
+		return AutoBlockConfig{}, fmt.Errorf("max_messages_per_minute deve ser maior que zero")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return AutoBlockConfig{}, fmt.Errorf("falha ao serializar configuração de bloqueio automático: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, autoBlockSettingKey, string(data)); err != nil {
+		return AutoBlockConfig{}, fmt.Errorf("falha ao salvar configuração de bloqueio automático: %w", err)
+	}
+
+	logger.Info("Configuração de bloqueio automático atualizada",
+		"user_id", userID,
+		"enabled", config.Enabled,
+		"max_messages_per_minute", config.MaxMessagesPerMinute)
+
+	return config, nil
+}
+
+// handleAutoBlockMessage blocks unknown contacts that exceed the message limit of the session
+func (sm *SessionManager) handleAutoBlockMessage(userID string, evt interface{}) error {
+	msg, ok := evt.(*events.Message)
+	if !ok || msg.Info.IsFromMe || msg.Info.IsGroup || msg.Info.Chat.Server == types.BroadcastServer {
+		return nil
+	}
+
+	config, err := sm.GetAutoBlockConfig(userID)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	sender := msg.Info.Sender.ToNonAD()
+	key := userID + "|" + sender.String()
+	count := sm.floodCounter.hit(key, time.Now())
+	if count <= config.MaxMessagesPerMinute {
+		return nil
+	}
+
+	client, exists := sm.sessionManager.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Contacts saved in the address book are never blocked automatically
+	contact := sender
+	if contact.Server != types.DefaultUserServer && msg.Info.SenderAlt.Server == types.DefaultUserServer {
+		contact = msg.Info.SenderAlt.ToNonAD()
+	}
+	info, err := client.WAClient.Store.Contacts.GetContact(ctx, contact)
+	if err != nil {
+		return fmt.Errorf("failed to look up contact: %w", err)
+	}
+	if info.FullName != "" || info.FirstName != "" {
+		return nil
+	}
+
+	sm.floodCounter.reset(key)
+
+	if _, err := client.WAClient.UpdateBlocklist(sender, events.BlocklistChangeActionBlock); err != nil {
+		return fmt.Errorf("failed to auto-block sender: %w", err)
+	}
+
+	logger.Warn("Remetente desconhecido bloqueado automaticamente",
+		"user_id", userID,
+		"sender", sender.String(),
+		"messages", count,
+		"limit", config.MaxMessagesPerMinute)
+
+	sm.publishAutoBlockEvent(userID, sender, count, config.MaxMessagesPerMinute)
+
+	return nil
+}
+
+// publishAutoBlockEvent publishes an automatic block to RabbitMQ
+func (sm *SessionManager) publishAutoBlockEvent(userID string, sender types.JID, count, limit int) {
+	publisher := sm.sessionManager.GetEventPublisher()
+	if publisher == nil {
+		return
+	}
+
+	eventType := "blocklist.auto_blocked"
+	data := map[string]interface{}{
+		"user_id":                 userID,
+		"event_type":              eventType,
+		"jid":                     sender.String(),
+		"messages":                count,
+		"max_messages_per_minute": limit,
+		"timestamp":               time.Now().Unix(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := publisher.PublishEvent(ctx, userID, eventType, data); err != nil {
+		logger.Error("Failed to publish auto-block event", "user_id", userID, "error", err)
+	}
+}
+
+// pruneFloodCounter periodically forgets senders that stopped messaging
+func (sm *SessionManager) pruneFloodCounter() {
+	ticker := time.NewTicker(autoBlockWindow)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sm.done:
+			return
+		case now := <-ticker.C:
+			sm.floodCounter.prune(now)
+		}
+	}
+}
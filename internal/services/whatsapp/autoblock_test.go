@@ -0,0 +1,116 @@
+package whatsapp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFloodCounterHit(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offsets  []time.Duration
+		expected int
+	}{
+		{
+			name:     "First message",
+			offsets:  []time.Duration{0},
+			expected: 1,
+		},
+		{
+			name:     "Messages within the window are counted",
+			offsets:  []time.Duration{0, 10 * time.Second, 30 * time.Second, 59 * time.Second},
+			expected: 4,
+		},
+		{
+			name:     "Messages older than the window are dropped",
+			offsets:  []time.Duration{0, 10 * time.Second, 65 * time.Second},
+			expected: 2,
+		},
+		{
+			name:     "Message exactly one window later starts over",
+			offsets:  []time.Duration{0, autoBlockWindow},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var counter floodCounter
+
+			var count int
+			for _, offset := range tt.offsets {
+				count = counter.hit("sender", start.Add(offset))
+			}
+
+			if count != tt.expected {
+				t.Errorf("For offsets %v, expected %d, but got %d", tt.offsets, tt.expected, count)
+			}
+		})
+	}
+}
+
+func TestFloodCounterSendersAreIndependent(t *testing.T) {
+	var counter floodCounter
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	counter.hit("first", now)
+	counter.hit("first", now.Add(time.Second))
+
+	if count := counter.hit("second", now.Add(2*time.Second)); count != 1 {
+		t.Errorf("Expected 1 message from the second sender, but got %d", count)
+	}
+
+	counter.reset("first")
+	if count := counter.hit("first", now.Add(3*time.Second)); count != 1 {
+		t.Errorf("Expected the count to restart after reset, but got %d", count)
+	}
+}
+
+func TestFloodCounterPrune(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		lastOffset time.Duration
+		pruneAt    time.Duration
+		kept       bool
+	}{
+		{
+			name:       "Recent sender is kept",
+			lastOffset: 0,
+			pruneAt:    30 * time.Second,
+			kept:       true,
+		},
+		{
+			name:       "Sender whose last message left the window is removed",
+			lastOffset: 0,
+			pruneAt:    autoBlockWindow,
+			kept:       false,
+		},
+		{
+			name:       "Sender with an old and a recent message is kept",
+			lastOffset: 50 * time.Second,
+			pruneAt:    90 * time.Second,
+			kept:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var counter floodCounter
+			counter.hit("sender", start)
+			if tt.lastOffset > 0 {
+				counter.hit("sender", start.Add(tt.lastOffset))
+			}
+
+			counter.prune(start.Add(tt.pruneAt))
+
+			_, kept := counter.senders["sender"]
+			if kept != tt.kept {
+				t.Errorf("For prune at %s, expected kept %v, but got %v", tt.pruneAt, tt.kept, kept)
+			}
+		})
+	}
+}
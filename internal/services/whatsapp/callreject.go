@@ -0,0 +1,142 @@
+// internal/services/whatsapp/callreject.go
+package whatsapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/services/whatsapp/messaging"
+	"yourproject/pkg/logger"
+)
+
+// callRejectSettingKey is the session_settings key holding the call rejection config
+const callRejectSettingKey = "call_reject"
+
+// CallRejectConfig defines whether incoming calls are rejected and how the caller is answered
+type CallRejectConfig struct {
+	Enabled      bool   `json:"enabled"`
+	AutoReply    bool   `json:"auto_reply"`
+	ReplyMessage string `json:"reply_message"`
+}
+
+// DefaultCallRejectConfig returns the call rejection config applied to sessions without a custom one
+func DefaultCallRejectConfig() CallRejectConfig {
+	return CallRejectConfig{
+		Enabled:      false,
+		AutoReply:    false,
+		ReplyMessage: "Este número não atende chamadas. Por favor, envie uma mensagem.",
+	}
+}
+
+// GetCallRejectConfig returns the call rejection config of a session
+func (sm *SessionManager) GetCallRejectConfig(userID string) (CallRejectConfig, error) {
+	value, exists, err := sm.sqlStore.GetSessionSetting(userID, callRejectSettingKey)
+	if err != nil {
+		return CallRejectConfig{}, err
+	}
+	if !exists {
+		return DefaultCallRejectConfig(), nil
+	}
+
+	config := DefaultCallRejectConfig()
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return CallRejectConfig{}, fmt.Errorf("configuração de rejeição de chamadas inválida: %w", err)
+	}
+
+	return config, nil
+}
+
+// SetCallRejectConfig validates and persists the call rejection config of a session
+func (sm *SessionManager) SetCallRejectConfig(userID string, config CallRejectConfig) (CallRejectConfig, error) {
+	config.ReplyMessage = strings.TrimSpace(config.ReplyMessage)
+
+	if config.AutoReply && config.ReplyMessage == "" {
+		return CallRejectConfig{}, fmt.Errorf("reply_message é obrigatório quando auto_reply está habilitado")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return CallRejectConfig{}, fmt.Errorf("falha ao serializar configuração de rejeição de chamadas: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, callRejectSettingKey, string(data)); err != nil {
+		return CallRejectConfig{}, fmt.Errorf("falha ao salvar configuração de rejeição de chamadas: %w", err)
+	}
+
+	logger.Info("Configuração de rejeição de chamadas atualizada",
+		"user_id", userID,
+		"enabled", config.Enabled,
+		"auto_reply", config.AutoReply)
+
+	return config, nil
+}
+
+// handleCallOffer rejects incoming calls when the session does not accept calls
+func (sm *SessionManager) handleCallOffer(userID string, evt interface{}) error {
+	var meta types.BasicCallMeta
+	switch offer := evt.(type) {
+	case *events.CallOffer:
+		meta = offer.BasicCallMeta
+	case *events.CallOfferNotice:
+		meta = offer.BasicCallMeta
+	default:
+		return nil
+	}
+
+	config, err := sm.GetCallRejectConfig(userID)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	client, exists := sm.sessionManager.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return nil
+	}
+
+	if err := client.WAClient.RejectCall(meta.From, meta.CallID); err != nil {
+		return fmt.Errorf("failed to reject call %s: %w", meta.CallID, err)
+	}
+
+	logger.Info("Chamada rejeitada automaticamente",
+		"user_id", userID,
+		"call_id", meta.CallID,
+		"from", meta.From.String())
+
+	sm.publishCallRejectEvent(userID, meta)
+
+	if config.AutoReply {
+		go sm.sendCallRejectReply(userID, meta.CallCreator.ToNonAD(), config.ReplyMessage)
+	}
+
+	return nil
+}
+
+// sendCallRejectReply tells the caller that the number does not answer calls.
+// The reply goes through SendText so it is paced by the session rate limiter and skipped for callers that opted out.
+func (sm *SessionManager) sendCallRejectReply(userID string, caller types.JID, message string) {
+	if _, err := sm.SendText(userID, caller.String(), message); err != nil {
+		if messaging.IsSuppressedError(err) {
+			logger.Debug("Resposta de chamada rejeitada não enviada: chamador na lista de supressão", "user_id", userID, "caller", caller.String())
+			return
+		}
+		logger.Error("Falha ao enviar resposta de chamada rejeitada", "user_id", userID, "caller", caller.String(), "error", err)
+	}
+}
+
+// publishCallRejectEvent publishes an automatic call rejection to RabbitMQ and the registered event handlers
+func (sm *SessionManager) publishCallRejectEvent(userID string, meta types.BasicCallMeta) {
+	sm.sessionManager.EmitEvent(userID, "call.auto_rejected", map[string]interface{}{
+		"call_id":      meta.CallID,
+		"from":         meta.From.String(),
+		"call_creator": meta.CallCreator.String(),
+		"timestamp":    time.Now().Unix(),
+	})
+}
@@ -0,0 +1,100 @@
+// internal/services/whatsapp/chatstate.go
+package whatsapp
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/storage"
+)
+
+// ListChats returns the stored chats of a session and how many chats it has
+func (sm *SessionManager) ListChats(userID string, limit, offset int) ([]storage.Chat, int, error) {
+	chats, err := sm.sqlStore.ListChats(userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := sm.sqlStore.CountChats(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return chats, total, nil
+}
+
+// GetChat returns a stored chat of a session, or nil when it is not stored
+func (sm *SessionManager) GetChat(userID, jid string) (*storage.Chat, error) {
+	return sm.sqlStore.GetChat(userID, jid)
+}
+
+// handleChatMessage makes every live message the last message of its chat.
+// Without the history opt-in only the metadata of the message is kept, not its text.
+func (sm *SessionManager) handleChatMessage(userID string, evt interface{}) error {
+	msg, ok := evt.(*events.Message)
+	if !ok || msg.Message == nil {
+		return nil
+	}
+
+	chat := msg.Info.Chat.ToNonAD()
+	if chat == types.StatusBroadcastJID || chat.Server == types.NewsletterServer {
+		return nil
+	}
+
+	// Reactions, edits and revokes change an existing message instead of adding one
+	if msg.Message.GetProtocolMessage() != nil || msg.Message.GetReactionMessage() != nil {
+		return nil
+	}
+
+	config, err := sm.GetHistorySyncConfig(userID)
+	if err != nil {
+		return err
+	}
+
+	stored := storage.StoredMessage{
+		ID:        msg.Info.ID,
+		ChatJID:   chat.String(),
+		SenderJID: msg.Info.Sender.ToNonAD().String(),
+		PushName:  msg.Info.PushName,
+		FromMe:    msg.Info.IsFromMe,
+		Type:      storedMessageType(msg.Message),
+		Timestamp: msg.Info.Timestamp,
+	}
+
+	// Message content is only kept for sessions that opted in to storing history
+	if config.Enabled {
+		stored.Text = storedMessageText(msg.Message)
+	}
+
+	return sm.sqlStore.RecordChatMessage(userID, stored)
+}
+
+// handleChatStateEvent applies archive, pin, mute, read, clear and delete actions made on any device to the stored chats
+func (sm *SessionManager) handleChatStateEvent(userID string, evt interface{}) error {
+	switch typedEvt := evt.(type) {
+	case *events.Archive:
+		return sm.sqlStore.SetChatArchived(userID, typedEvt.JID.String(), typedEvt.Action.GetArchived())
+	case *events.Pin:
+		return sm.sqlStore.SetChatPinned(userID, typedEvt.JID.String(), typedEvt.Action.GetPinned())
+	case *events.Mute:
+		var mutedUntil *time.Time
+		if typedEvt.Action.GetMuted() {
+			// WhatsApp sends -1 for chats muted forever
+			until := storage.ChatMutedForever
+			if typedEvt.Action.GetMuteEndTimestamp() > 0 {
+				until = time.UnixMilli(typedEvt.Action.GetMuteEndTimestamp())
+			}
+			mutedUntil = &until
+		}
+		return sm.sqlStore.SetChatMutedUntil(userID, typedEvt.JID.String(), mutedUntil)
+	case *events.MarkChatAsRead:
+		return sm.sqlStore.SetChatRead(userID, typedEvt.JID.String(), typedEvt.Action.GetRead())
+	case *events.ClearChat:
+		return sm.sqlStore.ClearChat(userID, typedEvt.JID.String())
+	case *events.DeleteChat:
+		return sm.sqlStore.DeleteChat(userID, typedEvt.JID.String())
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ package whatsapp
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.mau.fi/whatsmeow"
@@ -26,20 +28,113 @@ type Client struct {
 type SessionManager struct {
 	sessionManager *session.SessionManager
 	coordinator    *Coordinator
+	sqlStore       *storage.SQLStore
+	phoneRegions   sync.Map
+
+	// numberCacheMaxAge is the longest TTL of cached number lookups
+	numberCacheMaxAge atomic.Int64
+
+	// groupCacheSyncInterval is how often cached groups are reloaded from WhatsApp
+	groupCacheSyncInterval atomic.Int64
+
+	// profileCacheTTL is how long cached contact profiles are reused
+	profileCacheTTL atomic.Int64
+
+	// floodCounter counts recent messages per sender for the auto-block rule
+	floodCounter floodCounter
+
+	// historySyncQueue holds history sync blobs waiting to be stored
+	historySyncQueue chan historySyncJob
+
+	// done stops the background maintenance loops when the manager is closed
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 // NewSessionManager creates a new session manager with worker integration
 func NewSessionManager(sqlStore interface{}) *SessionManager {
+	store := sqlStore.(*storage.SQLStore)
+
 	// Create the underlying session manager
-	sessionMgr := session.NewSessionManager(sqlStore.(*storage.SQLStore))
+	sessionMgr := session.NewSessionManager(store)
 
 	// Create coordinator with worker integration
 	coord := NewCoordinator(sessionMgr)
 
-	return &SessionManager{
+	sm := &SessionManager{
 		sessionManager: sessionMgr,
 		coordinator:    coord,
+		sqlStore:       store,
+		done:           make(chan struct{}),
+
+		historySyncQueue: make(chan historySyncJob, historySyncQueueSize),
 	}
+
+	// Apply persisted per-session rate limits
+	sm.loadRateLimits()
+
+	// Refuse sends to recipients that opted out and detect new opt-outs
+	coord.messageService.SetSuppressionChecker(store)
+	coord.groupService.SetSuppressionChecker(store)
+	sessionMgr.RegisterEventHandler("message", sm.handleOptOutMessage)
+
+	// Group invites are direct messages sent outside the worker, so pace them like any other send
+	coord.groupService.SetSendPacer(sm.paceDirectSend)
+
+	// Parse numbers without a country code using the region of each session
+	coord.messageService.SetRegionResolver(sm.DefaultPhoneRegion)
+	coord.groupService.SetRegionResolver(sm.DefaultPhoneRegion)
+	coord.communityService.SetRegionResolver(sm.DefaultPhoneRegion)
+	coord.newsletterService.SetRegionResolver(sm.DefaultPhoneRegion)
+
+	// Reuse IsOnWhatsApp results instead of querying on every send
+	sm.SetNumberCacheTTL(0, 0)
+	go sm.purgeNumberCache()
+
+	// Serve group reads from the local cache, kept current by group events
+	coord.groupService.SetGroupCache(store)
+	sessionMgr.RegisterEventHandler("connection.update", sm.handleGroupCacheConnection)
+	sm.SetGroupCacheSyncInterval(0)
+	go sm.reconcileGroupCache()
+
+	// Reuse contact profiles, dropping them when the contact changes picture or about text
+	sm.SetProfileCacheTTL(0)
+	sessionMgr.RegisterEventHandler("contact.picture.updated", sm.handleProfileCacheEvent)
+	sessionMgr.RegisterEventHandler("contact.about.updated", sm.handleProfileCacheEvent)
+	go sm.purgeProfileCache()
+
+	// Block unknown contacts flooding the session when the rule is enabled
+	sessionMgr.RegisterEventHandler("message", sm.handleAutoBlockMessage)
+	go sm.pruneFloodCounter()
+
+	// Reject incoming calls for sessions that do not take calls
+	sessionMgr.RegisterEventHandler("call.offer", sm.handleCallOffer)
+
+	// Store the history sent after pairing for sessions that opted in
+	sessionMgr.RegisterEventHandler("history.sync.progress", sm.handleHistorySync)
+	go sm.storeHistorySyncs()
+
+	// Keep the stored chats current with new messages and actions made on other devices
+	coord.messageService.SetChatStore(store)
+	sessionMgr.RegisterEventHandler("message", sm.handleChatMessage)
+	sessionMgr.RegisterEventHandler("chat.updated", sm.handleChatStateEvent)
+
+	// Keep the stored contacts current with app state contact sync, push names and business names
+	sessionMgr.RegisterEventHandler("contact.updated", sm.handleContactEvent)
+	sessionMgr.RegisterEventHandler("contact.push_name.updated", sm.handleContactEvent)
+	sessionMgr.RegisterEventHandler("contact.business_name.updated", sm.handleContactEvent)
+
+	// Keep the stored business labels current with changes made on any device
+	coord.messageService.SetLabelStore(store)
+	sessionMgr.RegisterEventHandler("label.updated", sm.handleLabelEvent)
+	sessionMgr.RegisterEventHandler("label.deleted", sm.handleLabelEvent)
+	sessionMgr.RegisterEventHandler("label.chat.updated", sm.handleLabelEvent)
+	sessionMgr.RegisterEventHandler("label.message.updated", sm.handleLabelEvent)
+
+	// Download the media of statuses posted by contacts for sessions that opted in
+	sessionMgr.RegisterEventHandler("status.posted", sm.handleStatusPosted)
+
+	return sm
 }
 
 // GetAllSessions returns all active sessions
@@ -293,6 +388,9 @@ func (sm *SessionManager) DisconnectAll() {
 
 // Close closes the session manager and all workers
 func (sm *SessionManager) Close() error {
+	// Stop the background maintenance loops
+	sm.closeOnce.Do(func() { close(sm.done) })
+
 	// Stop coordinator (which stops worker pool)
 	if err := sm.coordinator.Stop(); err != nil {
 		logger.Error("Error stopping coordinator", "error", err)
@@ -369,31 +467,60 @@ func NewNewsletterService(sessionManager *SessionManager) *messaging.NewsletterS
 	return sessionManager.coordinator.newsletterService
 }
 
+// directMessageService paces a send that bypasses the worker and returns the coordinator's
+// message service, whose send methods apply the suppression checks
+func (sm *SessionManager) directMessageService(userID, to string) (*messaging.MessageService, error) {
+	if err := sm.paceDirectSend(userID, to); err != nil {
+		return nil, err
+	}
+	return sm.coordinator.GetConcreteMessageService(), nil
+}
+
 // Messaging methods for worker integration
 func (sm *SessionManager) SendText(userID, to, message string) (string, error) {
-	// Get the underlying message service
-	messageService := messaging.NewMessageService(sm.sessionManager)
+	messageService, err := sm.directMessageService(userID, to)
+	if err != nil {
+		return "", err
+	}
 	return messageService.SendText(userID, to, message)
 }
 
 func (sm *SessionManager) SendMedia(userID, to, mediaURL, mediaType, caption string) (string, error) {
-	// Get the underlying message service
-	messageService := messaging.NewMessageService(sm.sessionManager)
+	messageService, err := sm.directMessageService(userID, to)
+	if err != nil {
+		return "", err
+	}
 	return messageService.SendMedia(userID, to, mediaURL, mediaType, caption)
 }
 
 func (sm *SessionManager) SendButtons(userID, to, text, footer string, buttons []worker.ButtonData) (string, error) {
-	// Get the underlying message service - now uses worker types directly
-	messageService := messaging.NewMessageService(sm.sessionManager)
+	messageService, err := sm.directMessageService(userID, to)
+	if err != nil {
+		return "", err
+	}
 	return messageService.SendButtons(userID, to, text, footer, buttons)
 }
 
 func (sm *SessionManager) SendList(userID, to, text, footer, buttonText string, sections []worker.Section) (string, error) {
-	// Get the underlying message service - now uses worker types directly
-	messageService := messaging.NewMessageService(sm.sessionManager)
+	messageService, err := sm.directMessageService(userID, to)
+	if err != nil {
+		return "", err
+	}
 	return messageService.SendList(userID, to, text, footer, buttonText, sections)
 }
 
+func (sm *SessionManager) SendAlbum(userID, to string, items []worker.AlbumItem) (interface{}, error) {
+	messageService, err := sm.directMessageService(userID, to)
+	if err != nil {
+		return nil, err
+	}
+	result, err := messageService.SendAlbum(userID, to, items)
+	if album, ok := result.(*messaging.AlbumResult); ok {
+		sm.chargeDirectSend(userID, album.OutboundCount())
+	}
+	return result, err
+}
+
 // Newsletter methods for worker integration
 func (sm *SessionManager) CreateChannel(userID, name, description, pictureURL string) (interface{}, error) {
 	// Use the coordinator's newsletter service directly
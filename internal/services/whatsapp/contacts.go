@@ -0,0 +1,182 @@
+// internal/services/whatsapp/contacts.go
+package whatsapp
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/services/whatsapp/messaging"
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// ContactDetails is a stored contact together with its chat, when there is one
+type ContactDetails struct {
+	Contact storage.Contact `json:"contact"`
+	Chat    *storage.Chat   `json:"chat,omitempty"`
+}
+
+// ContactImportEntry is an address book entry to resolve on WhatsApp
+type ContactImportEntry struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone" binding:"required"`
+}
+
+// ContactImportResult summarises a contact import and reports each entry
+type ContactImportResult struct {
+	Received      int                           `json:"received"`
+	Resolved      int                           `json:"resolved"`
+	NotOnWhatsApp int                           `json:"not_on_whatsapp"`
+	Invalid       int                           `json:"invalid"`
+	Results       []messaging.NumberCheckResult `json:"results"`
+}
+
+// ListContacts returns the stored contacts of a session matching the search and how many match
+func (sm *SessionManager) ListContacts(userID, search string, limit, offset int) ([]storage.Contact, int, error) {
+	contacts, err := sm.sqlStore.ListContacts(userID, search, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := sm.sqlStore.CountContacts(userID, search)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return contacts, total, nil
+}
+
+// GetContact returns a stored contact by JID or phone number, or nil when it is not stored.
+// Phone numbers are normalised with the session region and every variant of the number is tried.
+func (sm *SessionManager) GetContact(userID, contact string) (*ContactDetails, error) {
+	contact = strings.TrimSpace(contact)
+	if contact == "" {
+		return nil, fmt.Errorf("contato não pode estar vazio")
+	}
+
+	var candidates []string
+	if strings.Contains(contact, "@") {
+		jid, err := types.ParseJID(contact)
+		if err != nil {
+			return nil, fmt.Errorf("JID inválido: %w", err)
+		}
+		candidates = []string{jid.ToNonAD().String()}
+	} else {
+		number, err := phone.Parse(contact, sm.DefaultPhoneRegion(userID))
+		if err != nil {
+			return nil, err
+		}
+		for _, digits := range number.Candidates() {
+			candidates = append(candidates, types.NewJID(digits, types.DefaultUserServer).String())
+		}
+	}
+
+	for _, candidate := range candidates {
+		stored, err := sm.sqlStore.GetContact(userID, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if stored == nil {
+			continue
+		}
+
+		chat, err := sm.sqlStore.GetChat(userID, stored.JID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ContactDetails{Contact: *stored, Chat: chat}, nil
+	}
+
+	return nil, nil
+}
+
+// ImportContacts resolves address book entries on WhatsApp and stores the ones registered there.
+// Entries are checked through the number cache, so repeated imports do not query WhatsApp again.
+func (sm *SessionManager) ImportContacts(userID string, entries []ContactImportEntry) (*ContactImportResult, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("nenhum contato informado")
+	}
+	if len(entries) > messaging.MaxNumberCheckBatch {
+		return nil, fmt.Errorf("máximo de %d contatos por importação, recebido %d", messaging.MaxNumberCheckBatch, len(entries))
+	}
+
+	numbers := make([]string, len(entries))
+	for i, entry := range entries {
+		numbers[i] = entry.Phone
+	}
+
+	checks, err := sm.CheckNumbers(userID, numbers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContactImportResult{
+		Received: len(entries),
+		Results:  checks,
+	}
+
+	var contacts []storage.Contact
+	for i, check := range checks {
+		switch {
+		case check.Error != "":
+			result.Invalid++
+		case !check.Exists:
+			result.NotOnWhatsApp++
+		default:
+			result.Resolved++
+			contacts = append(contacts, storage.Contact{
+				JID:        check.JID,
+				FullName:   strings.TrimSpace(entries[i].Name),
+				OnWhatsApp: true,
+			})
+		}
+	}
+
+	if len(contacts) > 0 {
+		if err := sm.sqlStore.ImportContacts(userID, contacts); err != nil {
+			return nil, fmt.Errorf("falha ao salvar contatos importados: %w", err)
+		}
+	}
+
+	logger.Info("Contatos importados",
+		"user_id", userID,
+		"received", result.Received,
+		"resolved", result.Resolved,
+		"not_on_whatsapp", result.NotOnWhatsApp,
+		"invalid", result.Invalid)
+
+	return result, nil
+}
+
+// handleContactEvent stores contact names synced from app state and the push and business names seen in messages.
+// Contacts addressed by LID are stored under their phone JID when WhatsApp sends it.
+func (sm *SessionManager) handleContactEvent(userID string, evt interface{}) error {
+	switch typedEvt := evt.(type) {
+	case *events.Contact:
+		jid := typedEvt.JID.ToNonAD()
+		lid := typedEvt.Action.GetLidJID()
+		if jid.Server == types.HiddenUserServer {
+			pn, err := types.ParseJID(typedEvt.Action.GetPnJID())
+			if err != nil || pn.Server != types.DefaultUserServer {
+				return sm.sqlStore.SaveContactName(userID, jid.String(), "", typedEvt.Action.GetFullName(), typedEvt.Action.GetFirstName())
+			}
+			lid = jid.String()
+			jid = pn.ToNonAD()
+		}
+		return sm.sqlStore.SaveContactName(userID, jid.String(), lid, typedEvt.Action.GetFullName(), typedEvt.Action.GetFirstName())
+	case *events.PushName:
+		jid := typedEvt.JID.ToNonAD()
+		if jid.Server == types.HiddenUserServer && typedEvt.Message != nil && typedEvt.Message.SenderAlt.Server == types.DefaultUserServer {
+			jid = typedEvt.Message.SenderAlt.ToNonAD()
+		}
+		return sm.sqlStore.SaveContactPushName(userID, jid.String(), typedEvt.NewPushName)
+	case *events.BusinessName:
+		return sm.sqlStore.SaveContactBusinessName(userID, typedEvt.JID.ToNonAD().String(), typedEvt.NewBusinessName)
+	}
+	return nil
+}
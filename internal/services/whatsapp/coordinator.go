@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"yourproject/internal/services/whatsapp/messaging"
 	"yourproject/internal/services/whatsapp/session"
@@ -54,6 +55,15 @@ func NewCoordinator(sessionMgr session.Manager) *Coordinator {
 	// Create worker pool with unified service as SessionManager, and services directly
 	coord.workerPool = worker.NewWorkerPool(unifiedService, coord, worker.DefaultConfig())
 
+	// Seed the rate limiter warm-up profile with the session creation time
+	coord.workerPool.SetSessionSinceFunc(func(userID string) (time.Time, bool) {
+		client, exists := sessionMgr.GetSession(userID)
+		if !exists {
+			return time.Time{}, false
+		}
+		return client.CreatedAt, true
+	})
+
 	return coord
 }
 
@@ -208,8 +218,11 @@ func (c *Coordinator) CreateWorker(userID string) error {
 		return fmt.Errorf("worker pool not initialized")
 	}
 
-	_, err := c.workerPool.CreateWorker(userID, worker.DefaultWorkerType)
-	return err
+	if _, err := c.workerPool.CreateWorker(userID, worker.DefaultWorkerType); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // RemoveWorker removes a worker for a user
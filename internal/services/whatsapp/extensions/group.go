@@ -0,0 +1,49 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow"
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// GetGroupInfoFromLink works like whatsmeow's GetGroupInfoFromLink but also returns
+// the group size sent by the server, which the parsed GroupInfo does not expose.
+// Invite previews usually list only part of the participants, so the size is the reliable count.
+func GetGroupInfoFromLink(cli *whatsmeow.Client, code string) (*types.GroupInfo, int, error) {
+	code = strings.TrimPrefix(code, whatsmeow.InviteLinkPrefix)
+
+	internals := cli.DangerousInternals()
+	resp, err := internals.SendGroupIQ(context.TODO(), "get", types.GroupServerJID, waBinary.Node{
+		Tag:   "invite",
+		Attrs: waBinary.Attrs{"code": code},
+	})
+	if errors.Is(err, whatsmeow.ErrIQGone) {
+		return nil, 0, fmt.Errorf("%w: %w", whatsmeow.ErrInviteLinkRevoked, err)
+	} else if errors.Is(err, whatsmeow.ErrIQNotAcceptable) {
+		return nil, 0, fmt.Errorf("%w: %w", whatsmeow.ErrInviteLinkInvalid, err)
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	groupNode, ok := resp.GetOptionalChildByTag("group")
+	if !ok {
+		return nil, 0, fmt.Errorf("missing group element in response to group link info query")
+	}
+
+	info, err := internals.ParseGroupNode(&groupNode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := groupNode.AttrGetter().OptionalInt("size")
+	if size < len(info.Participants) {
+		size = len(info.Participants)
+	}
+
+	return info, size, nil
+}
@@ -0,0 +1,83 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// statusAckTimeout matches the default time whatsmeow's SendMessage waits for the server acknowledgement
+const statusAckTimeout = 75 * time.Second
+
+// SendStatusToAudience posts a status update only to the given contacts.
+// whatsmeow's SendMessage always takes status recipients from the account's status privacy setting,
+// so this follows the same broadcast path with an explicit recipient list. The account's own devices
+// are always included. Like SendMessage, it returns once the server has acknowledged the message.
+func SendStatusToAudience(ctx context.Context, cli *whatsmeow.Client, message *waE2E.Message, audience []types.JID) (types.MessageID, error) {
+	if cli.Store.ID == nil {
+		return "", whatsmeow.ErrNotLoggedIn
+	}
+
+	internals := cli.DangerousInternals()
+
+	ownID := cli.Store.ID.ToNonAD()
+	participants := make([]types.JID, 0, len(audience)+1)
+	seen := map[types.JID]bool{ownID: true}
+	for _, jid := range audience {
+		jid = jid.ToNonAD()
+		if !seen[jid] {
+			seen[jid] = true
+			participants = append(participants, jid)
+		}
+	}
+	participants = append(participants, ownID)
+
+	id := cli.GenerateMessageID()
+	respChan := internals.WaitResponse(string(id))
+
+	// Keep the message so recipients that fail to decrypt it can ask for a retry
+	internals.AddRecentMessage(types.StatusBroadcastJID, id, message, nil)
+
+	if err := sendGroup(internals.SendGroup, ctx, types.StatusBroadcastJID, participants, id, message); err != nil {
+		internals.CancelResponse(string(id), respChan)
+		return "", fmt.Errorf("failed to send status: %w", err)
+	}
+
+	var resp *waBinary.Node
+	select {
+	case resp = <-respChan:
+	case <-time.After(statusAckTimeout):
+		internals.CancelResponse(string(id), respChan)
+		return "", whatsmeow.ErrMessageTimedOut
+	case <-ctx.Done():
+		internals.CancelResponse(string(id), respChan)
+		return "", ctx.Err()
+	}
+
+	// A disconnect while waiting means the server never acknowledged the status
+	if resp.Tag == "xmlstreamend" || resp.Tag == "stream:error" {
+		return "", fmt.Errorf("failed to send status: disconnected before the server acknowledged it")
+	}
+	if errorCode := resp.AttrGetter().OptionalInt("error"); errorCode != 0 {
+		return "", fmt.Errorf("%w %d", whatsmeow.ErrServerReturnedError, errorCode)
+	}
+
+	return id, nil
+}
+
+// sendGroup calls whatsmeow's internal SendGroup. Its last parameter is whatsmeow's unexported extra node params,
+// so the type is bound through inference and its zero value is passed, which is what status posts need.
+// A whatsmeow upgrade that changes the signature fails to compile here instead of failing at request time.
+func sendGroup[P any](
+	fn func(context.Context, types.JID, []types.JID, types.MessageID, *waE2E.Message, *whatsmeow.MessageDebugTimings, P) (string, []byte, error),
+	ctx context.Context, to types.JID, participants []types.JID, id types.MessageID, message *waE2E.Message,
+) error {
+	var extraParams P
+	_, _, err := fn(ctx, to, participants, id, message, &whatsmeow.MessageDebugTimings{}, extraParams)
+	return err
+}
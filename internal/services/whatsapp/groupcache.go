@@ -0,0 +1,65 @@
+// internal/services/whatsapp/groupcache.go
+package whatsapp
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/pkg/logger"
+)
+
+// DefaultGroupCacheSyncInterval is how often the group cache is reconciled with WhatsApp
+const DefaultGroupCacheSyncInterval = 6 * time.Hour
+
+// SetGroupCacheSyncInterval configures how often every connected session reloads its groups.
+// Zero keeps the default.
+func (sm *SessionManager) SetGroupCacheSyncInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultGroupCacheSyncInterval
+	}
+	sm.groupCacheSyncInterval.Store(int64(interval))
+
+	logger.Debug("Sincronização do cache de grupos configurada", "interval", interval)
+}
+
+// SyncGroupCache reloads every group of a session from WhatsApp
+func (sm *SessionManager) SyncGroupCache(userID string) (int, error) {
+	return sm.coordinator.groupService.SyncGroupCache(userID)
+}
+
+// handleGroupCacheConnection reloads the group cache when a session connects,
+// since group changes are not delivered while it is offline
+func (sm *SessionManager) handleGroupCacheConnection(userID string, evt interface{}) error {
+	if _, ok := evt.(*events.Connected); !ok {
+		return nil
+	}
+
+	go func() {
+		if _, err := sm.SyncGroupCache(userID); err != nil {
+			logger.Warn("Falha ao sincronizar cache de grupos", "user_id", userID, "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// reconcileGroupCache periodically reloads the groups of every connected session
+func (sm *SessionManager) reconcileGroupCache() {
+	for {
+		select {
+		case <-sm.done:
+			return
+		case <-time.After(time.Duration(sm.groupCacheSyncInterval.Load())):
+		}
+
+		for userID, client := range sm.sessionManager.GetAllSessions() {
+			if !client.IsConnected() {
+				continue
+			}
+			if _, err := sm.SyncGroupCache(userID); err != nil {
+				logger.Warn("Falha ao sincronizar cache de grupos", "user_id", userID, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// internal/services/whatsapp/groupexport.go
+package whatsapp
+
+import (
+	"fmt"
+
+	"yourproject/internal/services/whatsapp/messaging"
+)
+
+// GroupExportTargets resolves the groups of a participant export.
+// Without explicit JIDs it selects every group where the session is admin.
+func (sm *SessionManager) GroupExportTargets(userID string, groupJIDs []string, fresh bool) ([]string, error) {
+	if len(groupJIDs) > 0 {
+		return groupJIDs, nil
+	}
+
+	result, err := sm.coordinator.groupService.GetJoinedGroups(userID, fresh)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, ok := result.([]*messaging.GroupInfo)
+	if !ok {
+		return nil, fmt.Errorf("formato inesperado da lista de grupos: %T", result)
+	}
+
+	adminGroups := messaging.FilterAdminGroups(groups)
+	targets := make([]string, len(adminGroups))
+	for i, group := range adminGroups {
+		targets[i] = group.JID
+	}
+
+	return targets, nil
+}
+
+// ExportGroupParticipants loads the groups one at a time and hands their participants to emit,
+// so callers can stream large exports without holding every row in memory
+func (sm *SessionManager) ExportGroupParticipants(userID string, groupJIDs []string, fresh bool, emit func([]messaging.GroupParticipantRow) error) error {
+	for _, groupJID := range groupJIDs {
+		result, err := sm.coordinator.groupService.GetGroupInfo(userID, groupJID, fresh)
+		if err != nil {
+			return fmt.Errorf("grupo %s: %w", groupJID, err)
+		}
+
+		group, ok := result.(*messaging.GroupInfo)
+		if !ok {
+			return fmt.Errorf("formato inesperado das informações do grupo %s: %T", groupJID, result)
+		}
+
+		if err := emit(messaging.GroupParticipantRows(group)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
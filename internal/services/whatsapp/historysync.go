@@ -0,0 +1,308 @@
+// internal/services/whatsapp/historysync.go
+package whatsapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// historySyncSettingKey is the session_settings key holding the history sync config
+const historySyncSettingKey = "history_sync"
+
+// HistorySyncConfig defines whether the history sent after pairing is stored and how far back.
+// Zero limits keep everything WhatsApp sends.
+type HistorySyncConfig struct {
+	Enabled            bool `json:"enabled"`
+	MaxDays            int  `json:"max_days"`
+	MaxMessagesPerChat int  `json:"max_messages_per_chat"`
+}
+
+// DefaultHistorySyncConfig returns the history sync config applied to sessions without a custom one
+func DefaultHistorySyncConfig() HistorySyncConfig {
+	return HistorySyncConfig{
+		Enabled:            false,
+		MaxDays:            30,
+		MaxMessagesPerChat: 200,
+	}
+}
+
+// GetHistorySyncConfig returns the history sync config of a session
+func (sm *SessionManager) GetHistorySyncConfig(userID string) (HistorySyncConfig, error) {
+	value, exists, err := sm.sqlStore.GetSessionSetting(userID, historySyncSettingKey)
+	if err != nil {
+		return HistorySyncConfig{}, err
+	}
+	if !exists {
+		return DefaultHistorySyncConfig(), nil
+	}
+
+	config := DefaultHistorySyncConfig()
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return HistorySyncConfig{}, fmt.Errorf("configuração de sincronização de histórico inválida: %w", err)
+	}
+
+	return config, nil
+}
+
+// SetHistorySyncConfig validates and persists the history sync config of a session.
+// It only affects history received afterwards, so it must be set before pairing the device.
+func (sm *SessionManager) SetHistorySyncConfig(userID string, config HistorySyncConfig) (HistorySyncConfig, error) {
+	if config.MaxDays < 0 {
+		return HistorySyncConfig{}, fmt.Errorf("max_days não pode ser negativo")
+	}
+	if config.MaxMessagesPerChat < 0 {
+		return HistorySyncConfig{}, fmt.Errorf("max_messages_per_chat não pode ser negativo")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return HistorySyncConfig{}, fmt.Errorf("falha ao serializar configuração de sincronização de histórico: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, historySyncSettingKey, string(data)); err != nil {
+		return HistorySyncConfig{}, fmt.Errorf("falha ao salvar configuração de sincronização de histórico: %w", err)
+	}
+
+	logger.Info("Configuração de sincronização de histórico atualizada",
+		"user_id", userID,
+		"enabled", config.Enabled,
+		"max_days", config.MaxDays,
+		"max_messages_per_chat", config.MaxMessagesPerChat)
+
+	return config, nil
+}
+
+// ListMessages returns the stored messages of a chat sent before the given time
+func (sm *SessionManager) ListMessages(userID, chatJID string, before time.Time, limit int) ([]storage.StoredMessage, error) {
+	return sm.sqlStore.ListMessages(userID, chatJID, before, limit)
+}
+
+// historySyncQueueSize is how many history sync blobs can wait to be stored
+const historySyncQueueSize = 64
+
+// historySyncJob is a history sync blob waiting to be stored
+type historySyncJob struct {
+	userID string
+	sync   *events.HistorySync
+}
+
+// handleHistorySync queues a history sync blob to be stored in order outside the event loop.
+// whatsmeow dispatches history syncs from its own notification loop, so waiting for room in the
+// queue only holds back further history blobs, not the other events of the session.
+func (sm *SessionManager) handleHistorySync(userID string, evt interface{}) error {
+	sync, ok := evt.(*events.HistorySync)
+	if !ok || sync.Data == nil {
+		return nil
+	}
+
+	select {
+	case sm.historySyncQueue <- historySyncJob{userID: userID, sync: sync}:
+	case <-sm.done:
+	}
+
+	return nil
+}
+
+// storeHistorySyncs stores queued history sync blobs one at a time until the manager is closed
+func (sm *SessionManager) storeHistorySyncs() {
+	for {
+		select {
+		case <-sm.done:
+			return
+		case job := <-sm.historySyncQueue:
+			if err := sm.storeHistorySync(job.userID, job.sync); err != nil {
+				logger.Error("Falha ao armazenar histórico", "user_id", job.userID, "error", err)
+			}
+		}
+	}
+}
+
+// storeHistorySync stores the chats, contacts and messages of a history sync blob for sessions that opted in
+func (sm *SessionManager) storeHistorySync(userID string, sync *events.HistorySync) error {
+	config, err := sm.GetHistorySyncConfig(userID)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	client, exists := sm.sessionManager.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return nil
+	}
+
+	var cutoff time.Time
+	if config.MaxDays > 0 {
+		cutoff = time.Now().AddDate(0, 0, -config.MaxDays)
+	}
+
+	var batch storage.HistoryBatch
+	for _, pushName := range sync.Data.GetPushnames() {
+		if pushName.GetID() == "" || pushName.GetPushname() == "" {
+			continue
+		}
+		batch.Contacts = append(batch.Contacts, storage.Contact{
+			JID:      pushName.GetID(),
+			PushName: pushName.GetPushname(),
+		})
+	}
+
+	for _, conv := range sync.Data.GetConversations() {
+		chatJID, err := types.ParseJID(conv.GetID())
+		if err != nil || chatJID == types.StatusBroadcastJID {
+			continue
+		}
+
+		chat := storage.Chat{
+			JID:          chatJID.String(),
+			Name:         conv.GetName(),
+			UnreadCount:  int(conv.GetUnreadCount()),
+			MarkedUnread: conv.GetMarkedAsUnread(),
+			Archived:     conv.GetArchived(),
+			Pinned:       conv.GetPinned() > 0,
+		}
+		if conv.GetMuteEndTime() > 0 {
+			chat.MutedUntil = historyMuteEnd(conv.GetMuteEndTime())
+		}
+		if conv.GetConversationTimestamp() > 0 {
+			lastMessageAt := time.Unix(int64(conv.GetConversationTimestamp()), 0)
+			chat.LastMessageAt = &lastMessageAt
+		}
+
+		var messages []storage.StoredMessage
+		for _, historyMsg := range conv.GetMessages() {
+			msg, err := client.WAClient.ParseWebMessage(chatJID, historyMsg.GetMessage())
+			if err != nil {
+				logger.Debug("Mensagem do histórico ignorada", "user_id", userID, "chat", chat.JID, "error", err)
+				continue
+			}
+			if msg.Message == nil || (!cutoff.IsZero() && msg.Info.Timestamp.Before(cutoff)) {
+				continue
+			}
+
+			messages = append(messages, storage.StoredMessage{
+				ID:        msg.Info.ID,
+				ChatJID:   chat.JID,
+				SenderJID: msg.Info.Sender.ToNonAD().String(),
+				PushName:  msg.Info.PushName,
+				FromMe:    msg.Info.IsFromMe,
+				Type:      storedMessageType(msg.Message),
+				Text:      storedMessageText(msg.Message),
+				Timestamp: msg.Info.Timestamp,
+			})
+		}
+
+		// Keep the most recent messages when the chat has more than the limit
+		if config.MaxMessagesPerChat > 0 && len(messages) > config.MaxMessagesPerChat {
+			sort.Slice(messages, func(i, j int) bool {
+				return messages[i].Timestamp.After(messages[j].Timestamp)
+			})
+			messages = messages[:config.MaxMessagesPerChat]
+		}
+		batch.Messages = append(batch.Messages, messages...)
+
+		// The newest stored message becomes the preview of the chat, unless the chat has a newer one
+		var newest *storage.StoredMessage
+		for i := range messages {
+			if newest == nil || messages[i].Timestamp.After(newest.Timestamp) {
+				newest = &messages[i]
+			}
+		}
+		if newest != nil && (chat.LastMessageAt == nil || !newest.Timestamp.Before(*chat.LastMessageAt)) {
+			chat.LastMessageAt = &newest.Timestamp
+			chat.LastMessage = &storage.ChatMessagePreview{
+				ID:        newest.ID,
+				SenderJID: newest.SenderJID,
+				FromMe:    newest.FromMe,
+				Type:      newest.Type,
+				Text:      newest.Text,
+			}
+		}
+		batch.Chats = append(batch.Chats, chat)
+	}
+
+	if err := sm.sqlStore.SaveHistoryBatch(userID, batch); err != nil {
+		return fmt.Errorf("failed to store history sync: %w", err)
+	}
+
+	logger.Info("Histórico sincronizado",
+		"user_id", userID,
+		"sync_type", sync.Data.GetSyncType().String(),
+		"progress", sync.Data.GetProgress(),
+		"chats", len(batch.Chats),
+		"contacts", len(batch.Contacts),
+		"messages", len(batch.Messages))
+
+	return nil
+}
+
+// historyMuteEnd converts the mute expiry of a history sync conversation, which may be in seconds or milliseconds
+func historyMuteEnd(value uint64) *time.Time {
+	if value > uint64(storage.ChatMutedForever.UnixMilli()) {
+		return &storage.ChatMutedForever
+	}
+
+	until := time.Unix(int64(value), 0)
+	if value > 1e12 {
+		until = time.UnixMilli(int64(value))
+	}
+	return &until
+}
+
+// storedMessageType returns the kind of content of a message
+func storedMessageType(msg *waE2E.Message) string {
+	switch {
+	case msg.GetConversation() != "" || msg.GetExtendedTextMessage() != nil:
+		return "text"
+	case msg.GetImageMessage() != nil:
+		return "image"
+	case msg.GetVideoMessage() != nil:
+		return "video"
+	case msg.GetAudioMessage() != nil:
+		return "audio"
+	case msg.GetDocumentMessage() != nil:
+		return "document"
+	case msg.GetStickerMessage() != nil:
+		return "sticker"
+	case msg.GetLocationMessage() != nil || msg.GetLiveLocationMessage() != nil:
+		return "location"
+	case msg.GetContactMessage() != nil || msg.GetContactsArrayMessage() != nil:
+		return "contact"
+	case msg.GetPollCreationMessage() != nil || msg.GetPollCreationMessageV3() != nil:
+		return "poll"
+	case msg.GetReactionMessage() != nil:
+		return "reaction"
+	default:
+		return "other"
+	}
+}
+
+// storedMessageText returns the text or caption of a message
+func storedMessageText(msg *waE2E.Message) string {
+	switch {
+	case msg.GetConversation() != "":
+		return msg.GetConversation()
+	case msg.GetExtendedTextMessage() != nil:
+		return msg.GetExtendedTextMessage().GetText()
+	case msg.GetImageMessage() != nil:
+		return msg.GetImageMessage().GetCaption()
+	case msg.GetVideoMessage() != nil:
+		return msg.GetVideoMessage().GetCaption()
+	case msg.GetDocumentMessage() != nil:
+		return msg.GetDocumentMessage().GetCaption()
+	case msg.GetReactionMessage() != nil:
+		return msg.GetReactionMessage().GetText()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,40 @@
+// internal/services/whatsapp/labels.go
+package whatsapp
+
+import (
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/storage"
+)
+
+// ListLabels returns the stored business labels of a session
+func (sm *SessionManager) ListLabels(userID string) ([]storage.Label, error) {
+	return sm.sqlStore.ListLabels(userID)
+}
+
+// ListLabelChats returns the JIDs of the chats a label is attached to
+func (sm *SessionManager) ListLabelChats(userID, labelID string) ([]string, error) {
+	return sm.sqlStore.ListLabelChats(userID, labelID)
+}
+
+// handleLabelEvent applies label edits and label assignments made on any device to the stored labels
+func (sm *SessionManager) handleLabelEvent(userID string, evt interface{}) error {
+	switch typedEvt := evt.(type) {
+	case *events.LabelEdit:
+		if typedEvt.Action.GetDeleted() {
+			return sm.sqlStore.DeleteLabel(userID, typedEvt.LabelID)
+		}
+		return sm.sqlStore.SaveLabel(userID, storage.Label{
+			ID:           typedEvt.LabelID,
+			Name:         typedEvt.Action.GetName(),
+			Color:        typedEvt.Action.GetColor(),
+			PredefinedID: typedEvt.Action.GetPredefinedID(),
+		})
+	case *events.LabelAssociationChat:
+		return sm.sqlStore.SetChatLabel(userID, typedEvt.LabelID, typedEvt.JID.ToNonAD().String(), typedEvt.Action.GetLabeled())
+	case *events.LabelAssociationMessage:
+		return sm.sqlStore.SetMessageLabel(userID, typedEvt.LabelID, typedEvt.JID.ToNonAD().String(), typedEvt.MessageID,
+			typedEvt.Action.GetLabeled())
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+// internal/services/whatsapp/messaging/album.go
+package messaging
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// Limites de itens aceitos em um álbum
+const (
+	MinAlbumItems = 2
+	MaxAlbumItems = 30
+)
+
+// maxConcurrentAlbumUploads limita quantas mídias do álbum são baixadas e enviadas ao mesmo tempo
+const maxConcurrentAlbumUploads = 4
+
+// albumSendTimeout é o prazo de envio de cada mensagem do álbum
+const albumSendTimeout = 60 * time.Second
+
+// AlbumTimeout retorna quanto o envio de um álbum pode levar no pior caso: os uploads em lotes paralelos,
+// a mensagem do álbum e cada mídia com seu próprio prazo, mais a espera informada pelo limitador da sessão
+func AlbumTimeout(itemCount int, pacing time.Duration) time.Duration {
+	batches := (itemCount + maxConcurrentAlbumUploads - 1) / maxConcurrentAlbumUploads
+	uploads := time.Duration(batches) * (mediaDownloadTimeout + mediaUploadTimeout)
+	sends := time.Duration(itemCount+1) * albumSendTimeout
+
+	return pacing + uploads + sends
+}
+
+// AlbumItemResult representa o resultado do envio de um item do álbum
+type AlbumItemResult struct {
+	Index     int    `json:"index"`
+	MediaURL  string `json:"media_url"`
+	MessageID string `json:"message_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// AlbumResult representa o resultado do envio de um álbum
+type AlbumResult struct {
+	AlbumID string            `json:"album_id"`
+	Items   []AlbumItemResult `json:"items"`
+	Sent    int               `json:"sent"`
+	Failed  int               `json:"failed"`
+}
+
+// OutboundCount retorna quantas mídias do álbum foram enviadas, para o limitador de envio da sessão
+func (r *AlbumResult) OutboundCount() int {
+	return r.Sent
+}
+
+// uploadedAlbumItem guarda a mensagem pronta de um item cujo upload deu certo
+type uploadedAlbumItem struct {
+	message *waE2E.Message
+	err     error
+}
+
+// SendAlbum envia várias imagens e vídeos agrupados como álbum.
+// Os uploads são feitos em paralelo; itens que falharem são informados no resultado
+// sem impedir o envio dos demais.
+func (ms *MessageService) SendAlbum(userID, to string, items []worker.AlbumItem) (interface{}, error) {
+	if len(items) < MinAlbumItems || len(items) > MaxAlbumItems {
+		return nil, fmt.Errorf("o álbum deve ter entre %d e %d itens", MinAlbumItems, MaxAlbumItems)
+	}
+	for i, item := range items {
+		if item.MediaURL == "" {
+			return nil, fmt.Errorf("item %d: media_url não pode estar vazio", i)
+		}
+		if item.MediaType != "image" && item.MediaType != "video" {
+			return nil, fmt.Errorf("item %d: tipo de mídia não suportado em álbum: %s", i, item.MediaType)
+		}
+	}
+
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	validatedJID, err := ms.ValidateAndOrganizeRecipient(userID, to)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao validar destinatário: %w", err)
+	}
+
+	if strings.Contains(validatedJID, "@newsletter") {
+		return nil, fmt.Errorf("álbuns não são suportados em canais")
+	}
+
+	recipient, err := types.ParseJID(validatedJID)
+	if err != nil {
+		return nil, fmt.Errorf("JID inválido: %w", err)
+	}
+
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return nil, err
+	}
+
+	uploaded := ms.uploadAlbumItems(client.WAClient, items)
+
+	result := &AlbumResult{Items: make([]AlbumItemResult, len(items))}
+	var imageCount, videoCount uint32
+	for i, item := range items {
+		result.Items[i] = AlbumItemResult{Index: i, MediaURL: item.MediaURL}
+		if uploaded[i].err != nil {
+			result.Items[i].Error = uploaded[i].err.Error()
+			continue
+		}
+		if item.MediaType == "image" {
+			imageCount++
+		} else {
+			videoCount++
+		}
+	}
+
+	if imageCount+videoCount == 0 {
+		return nil, fmt.Errorf("falha ao enviar todas as mídias do álbum: %s", result.Items[0].Error)
+	}
+
+	// A mensagem de álbum anuncia quantas mídias virão; cada mídia aponta para ela
+	albumResp, err := sendAlbumMessage(client.WAClient, recipient, &waE2E.Message{
+		AlbumMessage: &waE2E.AlbumMessage{
+			ExpectedImageCount: proto.Uint32(imageCount),
+			ExpectedVideoCount: proto.Uint32(videoCount),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao enviar álbum: %w", err)
+	}
+	result.AlbumID = albumResp.ID
+
+	parentKey := &waCommon.MessageKey{
+		RemoteJID: proto.String(recipient.String()),
+		FromMe:    proto.Bool(true),
+		ID:        proto.String(albumResp.ID),
+	}
+
+	for i := range items {
+		if uploaded[i].err != nil {
+			result.Failed++
+			continue
+		}
+
+		message := uploaded[i].message
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("falha ao gerar segredo da mensagem: %w", err)
+		}
+		message.MessageContextInfo = &waE2E.MessageContextInfo{
+			MessageSecret: secret,
+			MessageAssociation: &waE2E.MessageAssociation{
+				AssociationType:  waE2E.MessageAssociation_MEDIA_ALBUM.Enum(),
+				ParentMessageKey: parentKey,
+			},
+		}
+
+		resp, err := sendAlbumMessage(client.WAClient, recipient, message)
+		if err != nil {
+			result.Items[i].Error = fmt.Sprintf("falha ao enviar mídia: %v", err)
+			result.Failed++
+			continue
+		}
+
+		result.Items[i].MessageID = resp.ID
+		result.Sent++
+	}
+
+	logger.Debug("Álbum enviado",
+		"user_id", userID,
+		"to", validatedJID,
+		"album_id", result.AlbumID,
+		"sent", result.Sent,
+		"failed", result.Failed)
+
+	return result, nil
+}
+
+// sendAlbumMessage envia uma mensagem do álbum com seu próprio timeout,
+// para que álbuns grandes não esgotem o prazo dos últimos itens
+func sendAlbumMessage(waClient *whatsmeow.Client, recipient types.JID, message *waE2E.Message) (whatsmeow.SendResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), albumSendTimeout)
+	defer cancel()
+
+	return waClient.SendMessage(ctx, recipient, message)
+}
+
+// uploadAlbumItems baixa e envia as mídias do álbum em paralelo, mantendo a ordem dos itens
+func (ms *MessageService) uploadAlbumItems(waClient *whatsmeow.Client, items []worker.AlbumItem) []uploadedAlbumItem {
+	uploaded := make([]uploadedAlbumItem, len(items))
+	semaphore := make(chan struct{}, maxConcurrentAlbumUploads)
+
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item worker.AlbumItem) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			message, err := uploadAlbumItem(waClient, item)
+			uploaded[i] = uploadedAlbumItem{message: message, err: err}
+		}(i, item)
+	}
+	wg.Wait()
+
+	return uploaded
+}
+
+// uploadAlbumItem baixa a mídia da URL, faz o upload e monta a mensagem de imagem ou vídeo
+func uploadAlbumItem(waClient *whatsmeow.Client, item worker.AlbumItem) (*waE2E.Message, error) {
+	uploadType := whatsmeow.MediaImage
+	if item.MediaType == "video" {
+		uploadType = whatsmeow.MediaVideo
+	}
+
+	media, err := uploadMediaFromURL(waClient, item.MediaURL, uploadType)
+	if err != nil {
+		return nil, err
+	}
+
+	return media.visualMessage(item.Caption), nil
+}
@@ -0,0 +1,43 @@
+package messaging
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlbumTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    int
+		pacing   time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "Smallest album uploads in one batch",
+			items:    MinAlbumItems,
+			pacing:   0,
+			expected: 90*time.Second + 3*60*time.Second,
+		},
+		{
+			name:     "Uploads are counted per batch of concurrent uploads",
+			items:    maxConcurrentAlbumUploads + 1,
+			pacing:   0,
+			expected: 2*90*time.Second + 6*60*time.Second,
+		},
+		{
+			name:     "Largest album includes the limiter wait",
+			items:    MaxAlbumItems,
+			pacing:   25 * time.Second,
+			expected: 25*time.Second + 8*90*time.Second + 31*60*time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AlbumTimeout(tt.items, tt.pacing)
+			if result != tt.expected {
+				t.Errorf("For %d items, expected %s, but got %s", tt.items, tt.expected, result)
+			}
+		})
+	}
+}
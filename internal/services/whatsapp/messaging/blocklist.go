@@ -0,0 +1,92 @@
+// internal/services/whatsapp/messaging/blocklist.go
+package messaging
+
+import (
+	"fmt"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/pkg/logger"
+)
+
+// Blocklist representa a lista de contatos bloqueados da conta
+type Blocklist struct {
+	DHash string   `json:"dhash,omitempty"`
+	JIDs  []string `json:"jids"`
+	Total int      `json:"total"`
+}
+
+// GetBlocklist retorna os contatos bloqueados pela conta
+func (ms *MessageService) GetBlocklist(userID string) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocklist, err := waClient.GetBlocklist()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter lista de bloqueados: %w", err)
+	}
+
+	return ToBlocklist(blocklist), nil
+}
+
+// BlockContact bloqueia um contato, informado por número ou JID
+func (ms *MessageService) BlockContact(userID, contact string) (interface{}, error) {
+	return ms.updateBlocklist(userID, contact, events.BlocklistChangeActionBlock)
+}
+
+// UnblockContact desbloqueia um contato, informado por número ou JID
+func (ms *MessageService) UnblockContact(userID, contact string) (interface{}, error) {
+	return ms.updateBlocklist(userID, contact, events.BlocklistChangeActionUnblock)
+}
+
+// updateBlocklist bloqueia ou desbloqueia o contato e retorna a lista atualizada
+func (ms *MessageService) updateBlocklist(userID, contact string, action events.BlocklistChangeAction) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := ms.resolveParticipantJID(userID, waClient, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := types.ParseJID(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("JID inválido: %w", err)
+	}
+
+	if jid.Server != types.DefaultUserServer && jid.Server != types.HiddenUserServer {
+		return nil, fmt.Errorf("apenas contatos podem ser bloqueados: %s", contact)
+	}
+
+	blocklist, err := waClient.UpdateBlocklist(jid.ToNonAD(), action)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao atualizar lista de bloqueados: %w", err)
+	}
+
+	logger.Debug("Lista de bloqueados atualizada",
+		"user_id", userID,
+		"jid", jid.String(),
+		"action", action)
+
+	return ToBlocklist(blocklist), nil
+}
+
+// ToBlocklist converte a lista de bloqueados do whatsmeow para a estrutura da API
+func ToBlocklist(blocklist *types.Blocklist) *Blocklist {
+	result := &Blocklist{JIDs: make([]string, 0)}
+	if blocklist == nil {
+		return result
+	}
+
+	result.DHash = blocklist.DHash
+	for _, jid := range blocklist.JIDs {
+		result.JIDs = append(result.JIDs, jid.String())
+	}
+	result.Total = len(result.JIDs)
+	return result
+}
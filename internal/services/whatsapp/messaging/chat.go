@@ -0,0 +1,302 @@
+// internal/services/whatsapp/messaging/chat.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// ChatStore guarda o estado das conversas da sessão
+type ChatStore interface {
+	GetChat(userID, jid string) (*storage.Chat, error)
+	SetChatArchived(userID, jid string, archived bool) error
+	SetChatPinned(userID, jid string, pinned bool) error
+	SetChatMutedUntil(userID, jid string, mutedUntil *time.Time) error
+	SetChatRead(userID, jid string, read bool) error
+	ClearChat(userID, jid string) error
+	DeleteChat(userID, jid string) error
+}
+
+// SetChatStore define onde o estado das conversas é guardado
+func (ms *MessageService) SetChatStore(store ChatStore) {
+	ms.chatStore = store
+}
+
+// ArchiveChat arquiva ou desarquiva uma conversa. Arquivar também desafixa a conversa.
+func (ms *MessageService) ArchiveChat(userID, chat string, archive bool) error {
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	lastMessageAt, lastMessageKey := ms.lastChatMessage(userID, target)
+	if err := sendChatPatch(waClient, appstate.BuildArchive(target, archive, lastMessageAt, lastMessageKey)); err != nil {
+		return fmt.Errorf("falha ao arquivar conversa: %w", err)
+	}
+
+	ms.updateChatStore(userID, target, "archived", func(store ChatStore) error {
+		return store.SetChatArchived(userID, target.String(), archive)
+	})
+
+	logger.Debug("Conversa arquivada", "user_id", userID, "chat", target.String(), "archived", archive)
+
+	return nil
+}
+
+// PinChat fixa ou desafixa uma conversa
+func (ms *MessageService) PinChat(userID, chat string, pin bool) error {
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	if err := sendChatPatch(waClient, appstate.BuildPin(target, pin)); err != nil {
+		return fmt.Errorf("falha ao fixar conversa: %w", err)
+	}
+
+	ms.updateChatStore(userID, target, "pinned", func(store ChatStore) error {
+		return store.SetChatPinned(userID, target.String(), pin)
+	})
+
+	logger.Debug("Conversa fixada", "user_id", userID, "chat", target.String(), "pinned", pin)
+
+	return nil
+}
+
+// MuteChat silencia uma conversa pela duração informada, ou reativa as notificações.
+// Duração zerada silencia a conversa para sempre.
+func (ms *MessageService) MuteChat(userID, chat string, mute bool, duration time.Duration) error {
+	if duration < 0 {
+		return fmt.Errorf("duração não pode ser negativa")
+	}
+
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	if err := sendChatPatch(waClient, appstate.BuildMute(target, mute, duration)); err != nil {
+		return fmt.Errorf("falha ao silenciar conversa: %w", err)
+	}
+
+	var mutedUntil *time.Time
+	if mute {
+		until := storage.ChatMutedForever
+		if duration > 0 {
+			until = time.Now().Add(duration)
+		}
+		mutedUntil = &until
+	}
+
+	ms.updateChatStore(userID, target, "muted_until", func(store ChatStore) error {
+		return store.SetChatMutedUntil(userID, target.String(), mutedUntil)
+	})
+
+	logger.Debug("Conversa silenciada", "user_id", userID, "chat", target.String(), "muted", mute, "duration", duration)
+
+	return nil
+}
+
+// MarkChatUnread marca uma conversa como não lida, ou como lida
+func (ms *MessageService) MarkChatUnread(userID, chat string, unread bool) error {
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	lastMessageAt, lastMessageKey := ms.lastChatMessage(userID, target)
+	patch := appstate.PatchInfo{
+		Type: appstate.WAPatchRegularLow,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexMarkChatAsRead, target.String()},
+			Version: 3,
+			Value: &waSyncAction.SyncActionValue{
+				MarkChatAsReadAction: &waSyncAction.MarkChatAsReadAction{
+					Read:         proto.Bool(!unread),
+					MessageRange: chatMessageRange(lastMessageAt, lastMessageKey),
+				},
+			},
+		}},
+	}
+
+	if err := sendChatPatch(waClient, patch); err != nil {
+		return fmt.Errorf("falha ao marcar conversa: %w", err)
+	}
+
+	ms.updateChatStore(userID, target, "read", func(store ChatStore) error {
+		return store.SetChatRead(userID, target.String(), !unread)
+	})
+
+	logger.Debug("Conversa marcada", "user_id", userID, "chat", target.String(), "unread", unread)
+
+	return nil
+}
+
+// ClearChat apaga as mensagens de uma conversa em todos os dispositivos, mantendo a conversa e as mensagens favoritas
+func (ms *MessageService) ClearChat(userID, chat string) error {
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	lastMessageAt, lastMessageKey := ms.lastChatMessage(userID, target)
+	patch := appstate.PatchInfo{
+		Type: appstate.WAPatchRegularHigh,
+		Mutations: []appstate.MutationInfo{{
+			// Os parâmetros indicam manter as mensagens favoritas e apagar as mídias
+			Index:   []string{appstate.IndexClearChat, target.String(), "1", "0"},
+			Version: 6,
+			Value: &waSyncAction.SyncActionValue{
+				ClearChatAction: &waSyncAction.ClearChatAction{
+					MessageRange: chatMessageRange(lastMessageAt, lastMessageKey),
+				},
+			},
+		}},
+	}
+
+	if err := sendChatPatch(waClient, patch); err != nil {
+		return fmt.Errorf("falha ao limpar conversa: %w", err)
+	}
+
+	ms.updateChatStore(userID, target, "cleared", func(store ChatStore) error {
+		return store.ClearChat(userID, target.String())
+	})
+
+	logger.Debug("Conversa limpa", "user_id", userID, "chat", target.String())
+
+	return nil
+}
+
+// DeleteChat apaga uma conversa em todos os dispositivos
+func (ms *MessageService) DeleteChat(userID, chat string) error {
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	lastMessageAt, lastMessageKey := ms.lastChatMessage(userID, target)
+	patch := appstate.PatchInfo{
+		Type: appstate.WAPatchRegularHigh,
+		Mutations: []appstate.MutationInfo{{
+			// O parâmetro indica apagar também as mídias da conversa
+			Index:   []string{appstate.IndexDeleteChat, target.String(), "1"},
+			Version: 6,
+			Value: &waSyncAction.SyncActionValue{
+				DeleteChatAction: &waSyncAction.DeleteChatAction{
+					MessageRange: chatMessageRange(lastMessageAt, lastMessageKey),
+				},
+			},
+		}},
+	}
+
+	if err := sendChatPatch(waClient, patch); err != nil {
+		return fmt.Errorf("falha ao apagar conversa: %w", err)
+	}
+
+	ms.updateChatStore(userID, target, "deleted", func(store ChatStore) error {
+		return store.DeleteChat(userID, target.String())
+	})
+
+	logger.Debug("Conversa apagada", "user_id", userID, "chat", target.String())
+
+	return nil
+}
+
+// chatTarget retorna o cliente da sessão e o JID da conversa, aceitando número de telefone ou JID
+func (ms *MessageService) chatTarget(userID, chat string) (*whatsmeow.Client, types.JID, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, types.JID{}, err
+	}
+
+	resolved, err := ms.resolveParticipantJID(userID, waClient, chat)
+	if err != nil {
+		return nil, types.JID{}, err
+	}
+
+	target, err := types.ParseJID(resolved)
+	if err != nil {
+		return nil, types.JID{}, fmt.Errorf("JID inválido: %w", err)
+	}
+
+	return waClient, target.ToNonAD(), nil
+}
+
+// lastChatMessage retorna a hora e a chave da última mensagem conhecida da conversa.
+// O WhatsApp usa esses dados para aplicar a ação somente até essa mensagem.
+func (ms *MessageService) lastChatMessage(userID string, target types.JID) (time.Time, *waCommon.MessageKey) {
+	if ms.chatStore == nil {
+		return time.Time{}, nil
+	}
+
+	chat, err := ms.chatStore.GetChat(userID, target.String())
+	if err != nil {
+		logger.Warn("Falha ao consultar conversa armazenada", "user_id", userID, "chat", target.String(), "error", err)
+		return time.Time{}, nil
+	}
+	if chat == nil || chat.LastMessageAt == nil {
+		return time.Time{}, nil
+	}
+	if chat.LastMessage == nil {
+		return *chat.LastMessageAt, nil
+	}
+
+	key := &waCommon.MessageKey{
+		RemoteJID: proto.String(target.String()),
+		FromMe:    proto.Bool(chat.LastMessage.FromMe),
+		ID:        proto.String(chat.LastMessage.ID),
+	}
+	if target.Server == types.GroupServer && !chat.LastMessage.FromMe && chat.LastMessage.SenderJID != "" {
+		key.Participant = proto.String(chat.LastMessage.SenderJID)
+	}
+
+	return *chat.LastMessageAt, key
+}
+
+// updateChatStore aplica a mudança no estado armazenado; os eventos de app state também a aplicam ao chegar
+func (ms *MessageService) updateChatStore(userID string, target types.JID, change string, update func(store ChatStore) error) {
+	if ms.chatStore == nil {
+		return
+	}
+	if err := update(ms.chatStore); err != nil {
+		logger.Warn("Falha ao atualizar conversa armazenada", "user_id", userID, "chat", target.String(), "change", change, "error", err)
+	}
+}
+
+// chatMessageRange monta o intervalo de mensagens afetado pela ação, até a última mensagem conhecida
+func chatMessageRange(lastMessageAt time.Time, lastMessageKey *waCommon.MessageKey) *waSyncAction.SyncActionMessageRange {
+	if lastMessageAt.IsZero() {
+		lastMessageAt = time.Now()
+	}
+
+	messageRange := &waSyncAction.SyncActionMessageRange{
+		LastMessageTimestamp: proto.Int64(lastMessageAt.Unix()),
+	}
+	if lastMessageKey != nil {
+		messageRange.Messages = []*waSyncAction.SyncActionMessage{{
+			Key:       lastMessageKey,
+			Timestamp: proto.Int64(lastMessageAt.Unix()),
+		}}
+	}
+
+	return messageRange
+}
+
+// sendChatPatch envia a mudança de app state das conversas
+func sendChatPatch(waClient *whatsmeow.Client, patch appstate.PatchInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return waClient.SendAppState(ctx, patch)
+}
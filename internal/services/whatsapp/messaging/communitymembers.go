@@ -0,0 +1,207 @@
+// internal/services/whatsapp/messaging/communitymembers.go
+package messaging
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/pkg/logger"
+)
+
+// CommunityMemberGroup representa um grupo da comunidade do qual o membro participa
+type CommunityMemberGroup struct {
+	JID            string `json:"jid"`
+	Name           string `json:"name"`
+	Role           string `json:"role"`
+	IsAnnouncement bool   `json:"is_announcement"`
+}
+
+// CommunityMember representa um membro único da comunidade e os grupos em que ele está
+type CommunityMember struct {
+	JID         string                 `json:"jid"`
+	PhoneNumber string                 `json:"phone_number,omitempty"`
+	LID         string                 `json:"lid,omitempty"`
+	DisplayName string                 `json:"display_name,omitempty"`
+	IsAdmin     bool                   `json:"is_admin"`
+	Groups      []CommunityMemberGroup `json:"groups"`
+}
+
+// CommunityMembers representa a visão consolidada dos membros de todos os grupos da comunidade
+type CommunityMembers struct {
+	CommunityJID string            `json:"community_jid"`
+	GroupsCount  int               `json:"groups_count"`
+	TotalMembers int               `json:"total_members"`
+	Members      []CommunityMember `json:"members"`
+	FailedGroups []string          `json:"failed_groups,omitempty"`
+}
+
+// GetCommunityAnnouncementGroup retorna o JID do grupo de avisos da comunidade
+func (cs *CommunityService) GetCommunityAnnouncementGroup(userID, communityJID string) (string, error) {
+	client, err := cs.getClient(userID)
+	if err != nil {
+		return "", err
+	}
+
+	communityID, err := types.ParseJID(communityJID)
+	if err != nil {
+		return "", fmt.Errorf("JID de comunidade inválido: %w", err)
+	}
+
+	if communityID.Server != types.GroupServer {
+		return "", fmt.Errorf("JID não é uma comunidade: %s", communityJID)
+	}
+
+	subGroups, err := client.GetSubGroups(communityID)
+	if err != nil {
+		return "", fmt.Errorf("falha ao obter grupos vinculados da comunidade: %w", err)
+	}
+
+	for _, subGroup := range subGroups {
+		if subGroup.IsDefaultSubGroup {
+			return subGroup.JID.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("comunidade não possui grupo de avisos: %s", communityJID)
+}
+
+// GetCommunityMembers consolida os participantes de todos os grupos vinculados à comunidade.
+// Grupos que não puderam ser consultados são listados em FailedGroups.
+func (cs *CommunityService) GetCommunityMembers(userID, communityJID string) (interface{}, error) {
+	client, err := cs.getClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := types.ParseJID(communityJID)
+	if err != nil {
+		return nil, fmt.Errorf("JID de comunidade inválido: %w", err)
+	}
+
+	if communityID.Server != types.GroupServer {
+		return nil, fmt.Errorf("JID não é uma comunidade: %s", communityJID)
+	}
+
+	subGroups, err := client.GetSubGroups(communityID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter grupos vinculados da comunidade: %w", err)
+	}
+
+	groups := make([]*GroupInfo, 0, len(subGroups))
+	var failed []string
+	for _, subGroup := range subGroups {
+		info, err := client.GetGroupInfo(subGroup.JID)
+		if err != nil {
+			logger.Warn("Falha ao obter participantes do grupo vinculado",
+				"user_id", userID,
+				"community_jid", communityJID,
+				"group_jid", subGroup.JID.String(),
+				"error", err)
+			failed = append(failed, subGroup.JID.String())
+			continue
+		}
+
+		group := ToGroupInfo(info, "")
+		// O grupo de avisos nem sempre traz a marcação nos metadados
+		group.Settings.IsDefaultSubGroup = group.Settings.IsDefaultSubGroup || subGroup.IsDefaultSubGroup
+		groups = append(groups, group)
+	}
+
+	members := AggregateCommunityMembers(communityID.String(), groups)
+	members.FailedGroups = failed
+
+	logger.Debug("Membros da comunidade consolidados",
+		"user_id", userID,
+		"community_jid", communityJID,
+		"groups_count", members.GroupsCount,
+		"total_members", members.TotalMembers,
+		"failed_groups", len(failed))
+
+	return members, nil
+}
+
+// AggregateCommunityMembers junta os participantes dos grupos, removendo duplicados.
+// O mesmo membro pode aparecer pelo número em um grupo e pelo LID em outro, por isso ambos são comparados.
+func AggregateCommunityMembers(communityJID string, groups []*GroupInfo) *CommunityMembers {
+	result := &CommunityMembers{
+		CommunityJID: communityJID,
+		GroupsCount:  len(groups),
+		Members:      make([]CommunityMember, 0),
+	}
+
+	byPhone := make(map[string]int)
+	byLID := make(map[string]int)
+
+	for _, group := range groups {
+		for _, p := range group.Participants {
+			phoneJID, lid := participantIdentity(p)
+
+			index, found := -1, false
+			if phoneJID != "" {
+				index, found = byPhone[phoneJID]
+			}
+			if !found && lid != "" {
+				index, found = byLID[lid]
+			}
+
+			if !found {
+				result.Members = append(result.Members, CommunityMember{JID: p.JID, Groups: make([]CommunityMemberGroup, 0, 1)})
+				index = len(result.Members) - 1
+			}
+
+			member := &result.Members[index]
+			if member.PhoneNumber == "" && phoneJID != "" {
+				member.PhoneNumber = phoneJID
+				member.JID = phoneJID
+			}
+			if member.LID == "" {
+				member.LID = lid
+			}
+			if member.DisplayName == "" {
+				member.DisplayName = p.DisplayName
+			}
+			member.IsAdmin = member.IsAdmin || p.IsAdmin || p.IsSuperAdmin
+
+			role := ParticipantRoleMember
+			switch {
+			case p.IsSuperAdmin:
+				role = ParticipantRoleSuperAdmin
+			case p.IsAdmin:
+				role = ParticipantRoleAdmin
+			}
+			member.Groups = append(member.Groups, CommunityMemberGroup{
+				JID:            group.JID,
+				Name:           group.Name,
+				Role:           role,
+				IsAnnouncement: group.Settings.IsDefaultSubGroup,
+			})
+
+			if phoneJID != "" {
+				byPhone[phoneJID] = index
+			}
+			if lid != "" {
+				byLID[lid] = index
+			}
+		}
+	}
+
+	result.TotalMembers = len(result.Members)
+	return result
+}
+
+// participantIdentity retorna o JID pelo número e o LID do participante, quando conhecidos
+func participantIdentity(p GroupParticipant) (string, string) {
+	phoneJID := p.PhoneNumber
+	if phoneJID == "" && strings.HasSuffix(p.JID, "@"+types.DefaultUserServer) {
+		phoneJID = p.JID
+	}
+
+	lid := p.LID
+	if lid == "" && strings.HasSuffix(p.JID, "@"+types.HiddenUserServer) {
+		lid = p.JID
+	}
+
+	return phoneJID, lid
+}
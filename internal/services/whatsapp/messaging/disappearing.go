@@ -0,0 +1,140 @@
+// internal/services/whatsapp/messaging/disappearing.go
+package messaging
+
+import (
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/pkg/logger"
+)
+
+// ParseDisappearingTimer converte o temporizador informado ("off", "24h", "7d" ou "90d") em duração.
+// Os apps oficiais ignoram outros valores, por isso eles são recusados.
+func ParseDisappearingTimer(timer string) (time.Duration, error) {
+	duration, ok := whatsmeow.ParseDisappearingTimerString(timer)
+	if !ok {
+		return 0, fmt.Errorf("temporizador de mensagens temporárias inválido: %s (deve ser 'off', '24h', '7d' ou '90d')", timer)
+	}
+	return duration, nil
+}
+
+// SetGroupDisappearingTimer define o temporizador de mensagens temporárias do grupo
+func (gs *GroupService) SetGroupDisappearingTimer(userID, groupJID, timer string) error {
+	client, exists := gs.groupManager.GetSession(userID)
+	if !exists {
+		return fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.IsConnected() {
+		return fmt.Errorf("sessão não conectada: %s", userID)
+	}
+
+	// Atualizar atividade
+	client.UpdateActivity()
+
+	// Converter para JID do grupo
+	groupID, err := types.ParseJID(groupJID)
+	if err != nil {
+		return fmt.Errorf("JID de grupo inválido: %w", err)
+	}
+
+	// Verificar se é realmente um grupo
+	if groupID.Server != types.GroupServer {
+		return fmt.Errorf("JID não é um grupo: %s", groupJID)
+	}
+
+	duration, err := ParseDisappearingTimer(timer)
+	if err != nil {
+		return err
+	}
+
+	err = client.GetWAClient().SetDisappearingTimer(groupID, duration)
+	if err != nil {
+		return fmt.Errorf("falha ao alterar mensagens temporárias do grupo: %w", err)
+	}
+
+	logger.Debug("Mensagens temporárias do grupo alteradas",
+		"user_id", userID,
+		"group_jid", groupJID,
+		"timer", duration)
+
+	return nil
+}
+
+// SetChatDisappearingTimer define o temporizador de mensagens temporárias de uma conversa individual
+func (ms *MessageService) SetChatDisappearingTimer(userID, to, timer string) error {
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists {
+		return fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.Connected {
+		return fmt.Errorf("cliente não está conectado")
+	}
+
+	duration, err := ParseDisappearingTimer(timer)
+	if err != nil {
+		return err
+	}
+
+	validatedJID, err := ms.ValidateAndOrganizeRecipient(userID, to)
+	if err != nil {
+		return fmt.Errorf("erro ao validar destinatário: %w", err)
+	}
+
+	chat, err := types.ParseJID(validatedJID)
+	if err != nil {
+		return fmt.Errorf("JID inválido: %w", err)
+	}
+
+	// Grupos usam o endpoint de grupo; o WhatsApp só aceita a mensagem de protocolo em conversas pelo número
+	if chat.Server != types.DefaultUserServer {
+		return fmt.Errorf("destinatário não é uma conversa individual: %s", to)
+	}
+
+	err = client.WAClient.SetDisappearingTimer(chat, duration)
+	if err != nil {
+		return fmt.Errorf("falha ao alterar mensagens temporárias da conversa: %w", err)
+	}
+
+	client.LastActive = time.Now()
+
+	logger.Debug("Mensagens temporárias da conversa alteradas",
+		"user_id", userID,
+		"chat_jid", chat.String(),
+		"timer", duration)
+
+	return nil
+}
+
+// SetDefaultDisappearingTimer define o temporizador padrão aplicado às novas conversas da conta
+func (ms *MessageService) SetDefaultDisappearingTimer(userID, timer string) error {
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists {
+		return fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.Connected {
+		return fmt.Errorf("cliente não está conectado")
+	}
+
+	duration, err := ParseDisappearingTimer(timer)
+	if err != nil {
+		return err
+	}
+
+	if err := client.WAClient.SetDefaultDisappearingTimer(duration); err != nil {
+		return fmt.Errorf("falha ao alterar mensagens temporárias padrão: %w", err)
+	}
+
+	client.LastActive = time.Now()
+
+	logger.Debug("Mensagens temporárias padrão alteradas",
+		"user_id", userID,
+		"timer", duration)
+
+	return nil
+}
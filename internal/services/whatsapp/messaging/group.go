@@ -3,6 +3,8 @@ package messaging
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"image"
 	"image/jpeg"
@@ -11,19 +13,31 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
 	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
 
+	"yourproject/internal/services/whatsapp/extensions"
 	"yourproject/internal/services/whatsapp/session"
 	"yourproject/pkg/logger"
 )
 
 // GroupService provides group management functionality
 type GroupService struct {
-	groupManager session.GroupManager
+	phoneResolver
+	groupManager       session.GroupManager
+	suppressionChecker SuppressionChecker
+	groupCache         GroupCache
+	sendPacer          SendPacer
 }
 
+// SendPacer aplica o limitador de envio da sessão a uma mensagem enviada fora do worker,
+// bloqueando até o horário reservado
+type SendPacer func(userID, to string) error
+
 // NewGroupService creates a new group service
 func NewGroupService(groupManager session.GroupManager) *GroupService {
 	return &GroupService{
@@ -31,6 +45,11 @@ func NewGroupService(groupManager session.GroupManager) *GroupService {
 	}
 }
 
+// SetSendPacer define o limitador aplicado aos convites enviados a quem não pôde ser adicionado
+func (gs *GroupService) SetSendPacer(pacer SendPacer) {
+	gs.sendPacer = pacer
+}
+
 func (gs *GroupService) CreateGroup(userID, name string, participants []string) (interface{}, error) {
 	logger.Info("Iniciando criação de grupo", "user_id", userID, "group_name", name, "participants", participants)
 	
@@ -141,8 +160,9 @@ func (gs *GroupService) CreateGroup(userID, name string, participants []string)
 	return response, nil
 }
 
-// GetGroupInfo - versão corrigida
-func (gs *GroupService) GetGroupInfo(userID, groupJID string) (interface{}, error) {
+// GetGroupInfo obtém as informações completas do grupo.
+// Com fresh falso, os dados podem vir do cache de grupos.
+func (gs *GroupService) GetGroupInfo(userID, groupJID string, fresh bool) (interface{}, error) {
 	client, exists := gs.groupManager.GetSession(userID)
 	if !exists {
 		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
@@ -166,7 +186,7 @@ func (gs *GroupService) GetGroupInfo(userID, groupJID string) (interface{}, erro
 	}
 
 	// Obter informações do grupo
-	groupInfo, err := client.GetWAClient().GetGroupInfo(jid)
+	groupInfo, cachedAt, err := gs.loadGroupInfo(userID, client.GetWAClient(), jid, fresh)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao obter informações do grupo: %w", err)
 	}
@@ -181,9 +201,11 @@ func (gs *GroupService) GetGroupInfo(userID, groupJID string) (interface{}, erro
 
 	// Converter para nossa estrutura expandida
 	expandedGroupInfo := ToGroupInfo(groupInfo, currentUserJID)
+	expandedGroupInfo.CachedAt = cachedAt
 
-	// Tentar obter link de convite (pode falhar se não for admin)
-	if expandedGroupInfo.UserPermissions.CanEditInfo {
+	// Tentar obter link de convite (pode falhar se não for admin).
+	// Leituras do cache não consultam o WhatsApp.
+	if cachedAt == nil && expandedGroupInfo.UserPermissions.CanEditInfo {
 		inviteLink, err := client.GetWAClient().GetGroupInviteLink(jid, false)
 		if err == nil && inviteLink != "" {
 			// Extrair código do link
@@ -231,8 +253,9 @@ func (gs *GroupService) debugGroupInfo(groupInfo *types.GroupInfo, context strin
 		"member_add_mode", string(groupInfo.MemberAddMode))
 }
 
-// GetJoinedGroups obtém lista completa de grupos em que o usuário é membro
-func (gs *GroupService) GetJoinedGroups(userID string) (interface{}, error) {
+// GetJoinedGroups obtém lista completa de grupos em que o usuário é membro.
+// Com fresh falso, a lista pode vir do cache de grupos.
+func (gs *GroupService) GetJoinedGroups(userID string, fresh bool) (interface{}, error) {
 	client, exists := gs.groupManager.GetSession(userID)
 	if !exists {
 		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
@@ -245,7 +268,7 @@ func (gs *GroupService) GetJoinedGroups(userID string) (interface{}, error) {
 	client.UpdateActivity()
 
 	// Obter lista de grupos
-	groups, err := client.GetWAClient().GetJoinedGroups()
+	groups, cachedAt, err := gs.loadJoinedGroups(userID, client.GetWAClient(), fresh)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao obter lista de grupos: %w", err)
 	}
@@ -262,81 +285,55 @@ func (gs *GroupService) GetJoinedGroups(userID string) (interface{}, error) {
 	result := make([]*GroupInfo, len(groups))
 	for i, group := range groups {
 		result[i] = ToGroupInfo(group, currentUserJID)
+		result[i].CachedAt = cachedAt[i]
 	}
 
 	logger.Debug("Lista de grupos obtida",
 		"user_id", userID,
-		"groups_count", len(groups))
+		"groups_count", len(groups),
+		"fresh", fresh)
 
 	return result, nil
 }
 
-// AddGroupParticipants adiciona participantes a um grupo
-func (gs *GroupService) AddGroupParticipants(userID, groupJID string, participants []string) error {
-	client, exists := gs.groupManager.GetSession(userID)
-	if !exists {
-		return fmt.Errorf("sessão não encontrada: %s", userID)
-	}
-
-	if !client.IsConnected() {
-		return fmt.Errorf("sessão não conectada: %s", userID)
-	}
-
-	// Atualizar atividade
-	client.UpdateActivity()
-
-	// Converter para JID do grupo
-	groupID, err := types.ParseJID(groupJID)
-	if err != nil {
-		return fmt.Errorf("JID de grupo inválido: %w", err)
-	}
-
-	// Verificar se é realmente um grupo
-	if groupID.Server != types.GroupServer {
-		return fmt.Errorf("JID não é um grupo: %s", groupJID)
-	}
+// groupParticipantsBatchSize limita quantos participantes são enviados em cada chamada ao WhatsApp.
+// Listas maiores são divididas automaticamente.
+const groupParticipantsBatchSize = 50
 
-	// Converter JIDs dos participantes com validação
-	var jids []types.JID
-	for _, p := range participants {
-		// Validate and process each participant phone number
-		validatedJID, err := gs.validateAndProcessParticipantNumber(userID, p)
-		if err != nil {
-			return fmt.Errorf("falha ao validar participante %s: %w", p, err)
-		}
+// groupInviteTimeout é o prazo de envio de cada convite para o grupo
+const groupInviteTimeout = 30 * time.Second
 
-		// Parse the validated JID string
-		jid, err := types.ParseJID(validatedJID)
-		if err != nil {
-			return fmt.Errorf("JID inválido para participante %s: %w", p, err)
-		}
-		jids = append(jids, jid)
-	}
+// AddGroupParticipants adiciona participantes a um grupo.
+// Se sendInvite for verdadeiro, quem não puder ser adicionado por privacidade recebe um convite para o grupo.
+func (gs *GroupService) AddGroupParticipants(userID, groupJID string, participants []string, sendInvite bool, inviteCaption string) (interface{}, error) {
+	return gs.updateGroupParticipants(userID, groupJID, participants, whatsmeow.ParticipantChangeAdd, sendInvite, inviteCaption)
+}
 
-	// Adicionar participantes
-	_, err = client.GetWAClient().UpdateGroupParticipants(groupID, jids, whatsmeow.ParticipantChangeAdd)
-	if err != nil {
-		return fmt.Errorf("falha ao adicionar participantes ao grupo: %w", err)
-	}
+// RemoveGroupParticipants remove participantes de um grupo
+func (gs *GroupService) RemoveGroupParticipants(userID, groupJID string, participants []string) (interface{}, error) {
+	return gs.updateGroupParticipants(userID, groupJID, participants, whatsmeow.ParticipantChangeRemove, false, "")
+}
 
-	// Log
-	logger.Debug("Participantes adicionados ao grupo",
-		"user_id", userID,
-		"group_jid", groupJID,
-		"participants_count", len(participants))
+// PromoteGroupParticipants promove participantes a admins
+func (gs *GroupService) PromoteGroupParticipants(userID, groupJID string, participants []string) (interface{}, error) {
+	return gs.updateGroupParticipants(userID, groupJID, participants, whatsmeow.ParticipantChangePromote, false, "")
+}
 
-	return nil
+// DemoteGroupParticipants rebaixa admins para participantes comuns
+func (gs *GroupService) DemoteGroupParticipants(userID, groupJID string, participants []string) (interface{}, error) {
+	return gs.updateGroupParticipants(userID, groupJID, participants, whatsmeow.ParticipantChangeDemote, false, "")
 }
 
-// RemoveGroupParticipants remove participantes de um grupo
-func (gs *GroupService) RemoveGroupParticipants(userID, groupJID string, participants []string) error {
+// updateGroupParticipants aplica a alteração em lotes e retorna o resultado de cada participante.
+// Participantes inválidos ou recusados pelo WhatsApp são reportados individualmente sem interromper os demais.
+func (gs *GroupService) updateGroupParticipants(userID, groupJID string, participants []string, action whatsmeow.ParticipantChange, sendInvite bool, inviteCaption string) (*GroupParticipantsUpdateResult, error) {
 	client, exists := gs.groupManager.GetSession(userID)
 	if !exists {
-		return fmt.Errorf("sessão não encontrada: %s", userID)
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
 	}
 
 	if !client.IsConnected() {
-		return fmt.Errorf("sessão não conectada: %s", userID)
+		return nil, fmt.Errorf("sessão não conectada: %s", userID)
 	}
 
 	// Atualizar atividade
@@ -345,158 +342,208 @@ func (gs *GroupService) RemoveGroupParticipants(userID, groupJID string, partici
 	// Converter para JID do grupo
 	groupID, err := types.ParseJID(groupJID)
 	if err != nil {
-		return fmt.Errorf("JID de grupo inválido: %w", err)
+		return nil, fmt.Errorf("JID de grupo inválido: %w", err)
 	}
 
 	// Verificar se é realmente um grupo
 	if groupID.Server != types.GroupServer {
-		return fmt.Errorf("JID não é um grupo: %s", groupJID)
+		return nil, fmt.Errorf("JID não é um grupo: %s", groupJID)
+	}
+
+	result := &GroupParticipantsUpdateResult{
+		GroupJID: groupJID,
+		Action:   string(action),
+		Results:  make([]GroupParticipantResult, len(participants)),
 	}
 
 	// Converter JIDs dos participantes com validação
 	var jids []types.JID
-	for _, p := range participants {
-		// Validate and process each participant phone number
+	var indexes []int
+	for i, p := range participants {
+		result.Results[i].Input = p
+
 		validatedJID, err := gs.validateAndProcessParticipantNumber(userID, p)
 		if err != nil {
-			return fmt.Errorf("falha ao validar participante %s: %w", p, err)
+			result.Results[i].Error = err.Error()
+			continue
 		}
 
-		// Parse the validated JID string
 		jid, err := types.ParseJID(validatedJID)
 		if err != nil {
-			return fmt.Errorf("JID inválido para participante %s: %w", p, err)
+			result.Results[i].Error = fmt.Sprintf("JID inválido: %v", err)
+			continue
 		}
-		jids = append(jids, jid)
-	}
 
-	// Remover participantes
-	_, err = client.GetWAClient().UpdateGroupParticipants(groupID, jids, whatsmeow.ParticipantChangeRemove)
-	if err != nil {
-		return fmt.Errorf("falha ao remover participantes do grupo: %w", err)
+		result.Results[i].JID = jid.String()
+		jids = append(jids, jid)
+		indexes = append(indexes, i)
 	}
 
-	// Log
-	logger.Debug("Participantes removidos do grupo",
-		"user_id", userID,
-		"group_jid", groupJID,
-		"participants_count", len(participants))
+	waClient := client.GetWAClient()
+	var privacyBlocked []int
+	for start := 0; start < len(jids); start += groupParticipantsBatchSize {
+		end := start + groupParticipantsBatchSize
+		if end > len(jids) {
+			end = len(jids)
+		}
 
-	return nil
-}
+		updated, err := waClient.UpdateGroupParticipants(groupID, jids[start:end], action)
+		if err != nil {
+			// Nenhum participante processado: não há resultado parcial para retornar
+			if start == 0 && end == len(jids) {
+				return nil, fmt.Errorf("falha ao atualizar participantes do grupo: %w", err)
+			}
+			for _, i := range indexes[start:end] {
+				result.Results[i].Error = err.Error()
+			}
+			continue
+		}
 
-// PromoteGroupParticipants promove participantes a admins
-func (gs *GroupService) PromoteGroupParticipants(userID, groupJID string, participants []string) error {
-	client, exists := gs.groupManager.GetSession(userID)
-	if !exists {
-		return fmt.Errorf("sessão não encontrada: %s", userID)
-	}
+		// O WhatsApp pode responder com o LID ou com o número do participante
+		byJID := make(map[string]types.GroupParticipant, len(updated)*2)
+		for _, participant := range updated {
+			byJID[participant.JID.ToNonAD().String()] = participant
+			if !participant.PhoneNumber.IsEmpty() {
+				byJID[participant.PhoneNumber.ToNonAD().String()] = participant
+			}
+			if !participant.LID.IsEmpty() {
+				byJID[participant.LID.ToNonAD().String()] = participant
+			}
+		}
 
-	if !client.IsConnected() {
-		return fmt.Errorf("sessão não conectada: %s", userID)
-	}
+		for n, i := range indexes[start:end] {
+			entry := &result.Results[i]
+			participant, ok := byJID[jids[start+n].ToNonAD().String()]
+			if !ok {
+				entry.Error = "participante ausente na resposta do WhatsApp"
+				continue
+			}
 
-	// Atualizar atividade
-	client.UpdateActivity()
+			entry.Status = participant.Error
+			if participant.Error != 0 {
+				entry.Error = describeParticipantError(participant.Error)
+				if participant.Error == 403 && participant.AddRequest != nil {
+					entry.inviteCode = participant.AddRequest.Code
+					entry.inviteExpiration = participant.AddRequest.Expiration
+					privacyBlocked = append(privacyBlocked, i)
+				}
+				continue
+			}
 
-	// Converter para JID do grupo
-	groupID, err := types.ParseJID(groupJID)
-	if err != nil {
-		return fmt.Errorf("JID de grupo inválido: %w", err)
+			entry.Status = 200
+			entry.Success = true
+		}
 	}
 
-	// Verificar se é realmente um grupo
-	if groupID.Server != types.GroupServer {
-		return fmt.Errorf("JID não é um grupo: %s", groupJID)
+	if sendInvite && action == whatsmeow.ParticipantChangeAdd && len(privacyBlocked) > 0 {
+		gs.sendGroupInvites(userID, waClient, groupID, result.Results, privacyBlocked, inviteCaption)
 	}
 
-	// Converter JIDs dos participantes com validação
-	var jids []types.JID
-	for _, p := range participants {
-		// Validate and process each participant phone number
-		validatedJID, err := gs.validateAndProcessParticipantNumber(userID, p)
-		if err != nil {
-			return fmt.Errorf("falha ao validar participante %s: %w", p, err)
+	for _, entry := range result.Results {
+		if entry.Success {
+			result.Succeeded++
+		} else {
+			result.Failed++
 		}
-
-		// Parse the validated JID string
-		jid, err := types.ParseJID(validatedJID)
-		if err != nil {
-			return fmt.Errorf("JID inválido para participante %s: %w", p, err)
+		if entry.InviteSent {
+			result.InvitesSent++
 		}
-		jids = append(jids, jid)
 	}
 
-	// Promover participantes
-	_, err = client.GetWAClient().UpdateGroupParticipants(groupID, jids, whatsmeow.ParticipantChangePromote)
-	if err != nil {
-		return fmt.Errorf("falha ao promover participantes do grupo: %w", err)
-	}
-
-	// Log
-	logger.Debug("Participantes promovidos a admins",
+	logger.Debug("Participantes do grupo atualizados",
 		"user_id", userID,
 		"group_jid", groupJID,
-		"participants_count", len(participants))
+		"action", action,
+		"participants_count", len(participants),
+		"succeeded", result.Succeeded,
+		"failed", result.Failed,
+		"invites_sent", result.InvitesSent)
 
-	return nil
+	return result, nil
 }
 
-// DemoteGroupParticipants rebaixa admins para participantes comuns
-func (gs *GroupService) DemoteGroupParticipants(userID, groupJID string, participants []string) error {
-	client, exists := gs.groupManager.GetSession(userID)
-	if !exists {
-		return fmt.Errorf("sessão não encontrada: %s", userID)
+// sendGroupInvites envia um convite para o grupo a quem não pôde ser adicionado por privacidade
+func (gs *GroupService) sendGroupInvites(userID string, waClient *whatsmeow.Client, groupID types.JID, results []GroupParticipantResult, indexes []int, caption string) {
+	groupName := ""
+	if info, _, err := gs.loadGroupInfo(userID, waClient, groupID, false); err == nil {
+		groupName = info.Name
+	} else {
+		logger.Warn("Falha ao obter nome do grupo para o convite", "user_id", userID, "group_jid", groupID.String(), "error", err)
 	}
 
-	if !client.IsConnected() {
-		return fmt.Errorf("sessão não conectada: %s", userID)
-	}
+	for _, i := range indexes {
+		entry := &results[i]
 
-	// Atualizar atividade
-	client.UpdateActivity()
+		recipient, err := types.ParseJID(entry.JID)
+		if err != nil {
+			entry.InviteError = fmt.Sprintf("JID inválido: %v", err)
+			continue
+		}
 
-	// Converter para JID do grupo
-	groupID, err := types.ParseJID(groupJID)
-	if err != nil {
-		return fmt.Errorf("JID de grupo inválido: %w", err)
-	}
+		if err := checkRecipientSuppression(gs.suppressionChecker, waClient, userID, recipient); err != nil {
+			entry.InviteError = err.Error()
+			continue
+		}
 
-	// Verificar se é realmente um grupo
-	if groupID.Server != types.GroupServer {
-		return fmt.Errorf("JID não é um grupo: %s", groupJID)
-	}
+		msg := &waE2E.Message{
+			GroupInviteMessage: &waE2E.GroupInviteMessage{
+				GroupJID:         proto.String(groupID.String()),
+				InviteCode:       proto.String(entry.inviteCode),
+				InviteExpiration: proto.Int64(entry.inviteExpiration.Unix()),
+				GroupName:        proto.String(groupName),
+			},
+		}
+		if caption != "" {
+			msg.GroupInviteMessage.Caption = proto.String(caption)
+		}
 
-	// Converter JIDs dos participantes com validação
-	var jids []types.JID
-	for _, p := range participants {
-		// Validate and process each participant phone number
-		validatedJID, err := gs.validateAndProcessParticipantNumber(userID, p)
-		if err != nil {
-			return fmt.Errorf("falha ao validar participante %s: %w", p, err)
+		// Convites são mensagens diretas a quem não está nos contatos: respeitar o limitador da sessão
+		if gs.sendPacer != nil {
+			if err := gs.sendPacer(userID, recipient.String()); err != nil {
+				entry.InviteError = err.Error()
+				continue
+			}
 		}
 
-		// Parse the validated JID string
-		jid, err := types.ParseJID(validatedJID)
-		if err != nil {
-			return fmt.Errorf("JID inválido para participante %s: %w", p, err)
+		if err := sendGroupInvite(waClient, recipient, msg); err != nil {
+			entry.InviteError = fmt.Sprintf("falha ao enviar convite: %v", err)
+			logger.Warn("Falha ao enviar convite do grupo", "user_id", userID, "group_jid", groupID.String(), "participant", entry.JID, "error", err)
+			continue
 		}
-		jids = append(jids, jid)
-	}
 
-	// Rebaixar participantes
-	_, err = client.GetWAClient().UpdateGroupParticipants(groupID, jids, whatsmeow.ParticipantChangeDemote)
-	if err != nil {
-		return fmt.Errorf("falha ao rebaixar participantes do grupo: %w", err)
+		entry.InviteSent = true
 	}
+}
 
-	// Log
-	logger.Debug("Admins rebaixados a participantes comuns",
-		"user_id", userID,
-		"group_jid", groupJID,
-		"participants_count", len(participants))
+// sendGroupInvite envia um convite de grupo com seu próprio timeout
+func sendGroupInvite(waClient *whatsmeow.Client, recipient types.JID, msg *waE2E.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), groupInviteTimeout)
+	defer cancel()
 
-	return nil
+	_, err := waClient.SendMessage(ctx, recipient, msg)
+	return err
+}
+
+// describeParticipantError traduz o código retornado pelo WhatsApp para um participante
+func describeParticipantError(code int) string {
+	switch code {
+	case 401:
+		return "sem permissão para alterar este participante"
+	case 403:
+		return "as configurações de privacidade do participante não permitem adicioná-lo"
+	case 404:
+		return "participante não encontrado no WhatsApp ou no grupo"
+	case 406:
+		return "participante não pode ser adicionado a grupos"
+	case 408:
+		return "participante saiu do grupo recentemente"
+	case 409:
+		return "participante já está no grupo ou já possui este papel"
+	case 500:
+		return "grupo atingiu o limite de participantes"
+	default:
+		return fmt.Sprintf("erro %d", code)
+	}
 }
 
 // UpdateGroupName atualiza o nome do grupo
@@ -717,6 +764,7 @@ func (gs *GroupService) LeaveGroup(userID, groupJID string) error {
 	if err != nil {
 		return fmt.Errorf("falha ao sair do grupo: %w", err)
 	}
+	gs.forgetCachedGroup(userID, groupJID)
 
 	// Log
 	logger.Debug("Saiu do grupo",
@@ -818,18 +866,11 @@ func (gs *GroupService) JoinGroupWithLink(userID, link string) (interface{}, err
 	// Atualizar atividade
 	client.UpdateActivity()
 
-	// Normalizar link de convite
-	if !strings.HasPrefix(link, "https://chat.whatsapp.com/") {
-		// Verificar se é apenas o código
-		if !strings.Contains(link, "/") {
-			link = "https://chat.whatsapp.com/" + link
-		} else {
-			return nil, fmt.Errorf("link de convite inválido: %s", link)
-		}
-	}
-
 	// Extrair código do link
-	code := strings.TrimPrefix(link, "https://chat.whatsapp.com/")
+	code, err := parseGroupInviteCode(link)
+	if err != nil {
+		return nil, err
+	}
 
 	// Entrar no grupo
 	groupID, err := client.GetWAClient().JoinGroupWithLink(code)
@@ -843,7 +884,102 @@ func (gs *GroupService) JoinGroupWithLink(userID, link string) (interface{}, err
 		"group_jid", groupID.String())
 
 	// Obter informações do grupo
-	return gs.GetGroupInfo(userID, groupID.String())
+	return gs.GetGroupInfo(userID, groupID.String(), true)
+}
+
+// GetGroupInviteInfo obtém as informações de um grupo ou comunidade pelo link de convite, sem entrar
+func (gs *GroupService) GetGroupInviteInfo(userID, link string) (interface{}, error) {
+	client, exists := gs.groupManager.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.IsConnected() {
+		return nil, fmt.Errorf("sessão não conectada: %s", userID)
+	}
+
+	// Atualizar atividade
+	client.UpdateActivity()
+
+	// Extrair código do link
+	code, err := parseGroupInviteCode(link)
+	if err != nil {
+		return nil, err
+	}
+
+	// Consultar o convite
+	groupInfo, size, err := extensions.GetGroupInfoFromLink(client.GetWAClient(), code)
+	if err != nil {
+		switch {
+		case errors.Is(err, whatsmeow.ErrInviteLinkRevoked):
+			return nil, fmt.Errorf("link de convite revogado: %w", err)
+		case errors.Is(err, whatsmeow.ErrInviteLinkInvalid):
+			return nil, fmt.Errorf("link de convite inválido: %w", err)
+		}
+		return nil, fmt.Errorf("falha ao obter informações do convite: %w", err)
+	}
+
+	preview := &GroupInvitePreview{
+		JID:                    groupInfo.JID.String(),
+		InviteCode:             code,
+		Name:                   groupInfo.Name,
+		Topic:                  groupInfo.Topic,
+		Size:                   size,
+		IsCommunity:            groupInfo.IsParent,
+		IsDefaultSubGroup:      groupInfo.IsDefaultSubGroup,
+		IsAnnounce:             groupInfo.IsAnnounce,
+		IsJoinApprovalRequired: groupInfo.IsJoinApprovalRequired,
+	}
+	if !groupInfo.OwnerJID.IsEmpty() {
+		preview.OwnerJID = groupInfo.OwnerJID.String()
+	}
+	if !groupInfo.GroupCreated.IsZero() {
+		created := groupInfo.GroupCreated
+		preview.Created = &created
+	}
+	if !groupInfo.LinkedParentJID.IsEmpty() {
+		preview.CommunityJID = groupInfo.LinkedParentJID.String()
+	}
+
+	// Só membros conseguem consultar as informações completas do grupo
+	if _, err := client.GetWAClient().GetGroupInfo(groupInfo.JID); err == nil {
+		preview.IsMember = true
+	}
+
+	// Log
+	logger.Debug("Informações do convite obtidas",
+		"user_id", userID,
+		"group_jid", preview.JID,
+		"size", preview.Size,
+		"is_community", preview.IsCommunity,
+		"is_member", preview.IsMember)
+
+	return preview, nil
+}
+
+// parseGroupInviteCode extrai o código de um link de convite (https://chat.whatsapp.com/CODE) ou aceita o código puro
+func parseGroupInviteCode(link string) (string, error) {
+	link = strings.TrimSpace(link)
+
+	// Normalizar link de convite
+	if !strings.HasPrefix(link, "https://chat.whatsapp.com/") {
+		// Verificar se é apenas o código
+		if link != "" && !strings.Contains(link, "/") {
+			return link, nil
+		}
+		return "", fmt.Errorf("link de convite inválido: %s", link)
+	}
+
+	// Extrair código do link, ignorando parâmetros
+	code := strings.TrimPrefix(link, "https://chat.whatsapp.com/")
+	if i := strings.IndexAny(code, "?#/"); i >= 0 {
+		code = code[:i]
+	}
+	if code == "" {
+		return "", fmt.Errorf("link de convite inválido: %s", link)
+	}
+
+	return code, nil
 }
 
 // convertToJPEG converts image data to JPEG format with WhatsApp-compatible settings
@@ -960,275 +1096,12 @@ func convertToJPEG(imageData []byte, quality int) ([]byte, error) {
 
 // validateAndProcessParticipantNumber validates and processes participant phone numbers
 func (gs *GroupService) validateAndProcessParticipantNumber(userID, phoneNumber string) (string, error) {
-	// Clean the input
-	phoneNumber = strings.TrimSpace(phoneNumber)
-
-	if phoneNumber == "" {
-		return "", fmt.Errorf("número do participante não pode estar vazio")
-	}
-
-	// Check if it's already a valid JID format
-	if strings.Contains(phoneNumber, "@") {
-		_, err := types.ParseJID(phoneNumber)
-		if err != nil {
-			return "", fmt.Errorf("JID inválido: %w", err)
-		}
-		return phoneNumber, nil
+	// Resolve the registered JID only when the session is connected
+	var waClient *whatsmeow.Client
+	if client, exists := gs.groupManager.GetSession(userID); exists && client.IsConnected() {
+		waClient = client.GetWAClient()
 	}
-
-	// If it's a phone number, validate and process it
-	if gs.isPhoneNumber(phoneNumber) {
-		return gs.validateAndProcessPhoneNumber(userID, phoneNumber)
-	}
-
-	// Default: treat as phone number
-	return gs.validateAndProcessPhoneNumber(userID, phoneNumber)
-}
-
-// isPhoneNumber checks if the input looks like a phone number
-func (gs *GroupService) isPhoneNumber(input string) bool {
-	// Remove common phone number characters
-	cleaned := strings.ReplaceAll(input, "+", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-
-	// Check if all remaining characters are digits
-	for _, r := range cleaned {
-		if r < '0' || r > '9' {
-			return false
-		}
-	}
-
-	// Must have at least 10 digits for a valid phone number
-	return len(cleaned) >= 10
-}
-
-// validateAndProcessPhoneNumber processes and validates phone numbers for participants
-func (gs *GroupService) validateAndProcessPhoneNumber(userID, phoneNumber string) (string, error) {
-	// Clean the phone number
-	cleaned := gs.cleanPhoneNumber(phoneNumber)
-
-	// Handle Brazilian numbers with the 9-digit rule
-	processed := gs.processBrazilianNumber(cleaned)
-
-	// Check if the number exists on WhatsApp and get the correct JID
-	jid, exists, err := gs.checkNumberExistsOnWhatsAppAndGetJID(userID, processed)
-	if err != nil {
-		logger.Debug("Erro ao verificar número no WhatsApp", "number", processed, "error", err)
-		// Continue even if verification fails, but log the error
-	}
-
-	if !exists {
-		// Try with the alternative format for Brazilian numbers
-		if strings.HasPrefix(processed, "55") && len(processed) >= 12 {
-			var alternatives []string
-
-			if len(processed) == 13 {
-				// 13 digits: try removing the 9
-				alternative := gs.removeNinthDigitFromBrazilian(processed)
-				if alternative != processed {
-					alternatives = append(alternatives, alternative)
-				}
-			} else if len(processed) == 12 {
-				// 12 digits: try adding the 9
-				alternative := gs.addNinthDigitToBrazilian(processed)
-				if alternative != processed {
-					alternatives = append(alternatives, alternative)
-				}
-			} else if len(processed) == 11 {
-				// 11 digits: This could be a landline or mobile without country code added incorrectly
-				// Try to re-process as 9-digit number by adding country code
-				withoutCountryCode := processed[2:] // Remove "55"
-				if len(withoutCountryCode) == 9 {
-					// Add 55 back and try both with and without 9
-					alternatives = append(alternatives, "55"+withoutCountryCode) // As-is
-
-					// Try adding 9 if it doesn't start with 9
-					if !strings.HasPrefix(withoutCountryCode, "9") {
-						alternatives = append(alternatives, "55"+withoutCountryCode[:2]+"9"+withoutCountryCode[2:])
-					}
-
-					// Try removing 9 if it starts with 9
-					if strings.HasPrefix(withoutCountryCode, "9") && len(withoutCountryCode) == 9 {
-						alternatives = append(alternatives, "55"+withoutCountryCode[1:])
-					}
-				}
-			}
-
-			// Test each alternative
-			for _, alt := range alternatives {
-				altJID, altExists, altErr := gs.checkNumberExistsOnWhatsAppAndGetJID(userID, alt)
-				if altErr == nil && altExists {
-					return altJID, nil
-				}
-			}
-		}
-	}
-
-	if exists && jid != "" {
-		return jid, nil
-	}
-
-	// If no valid JID found, fallback to manual construction
-	fallbackJID := processed + "@s.whatsapp.net"
-	logger.Debug("Usando JID de fallback", "number", processed, "jid", fallbackJID)
-	return fallbackJID, nil
-}
-
-// cleanPhoneNumber removes formatting characters from phone number
-func (gs *GroupService) cleanPhoneNumber(phone string) string {
-	// Remove all non-digit characters except +
-	cleaned := strings.ReplaceAll(phone, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-	cleaned = strings.ReplaceAll(cleaned, ".", "")
-
-	// Remove + if present
-	cleaned = strings.TrimPrefix(cleaned, "+")
-
-	return cleaned
-}
-
-// processBrazilianNumber handles Brazilian number formatting
-func (gs *GroupService) processBrazilianNumber(number string) string {
-	// If it doesn't start with country code, check if it's a Brazilian local number
-	if !strings.HasPrefix(number, "55") && len(number) >= 10 && len(number) <= 11 {
-		// Check if it looks like a Brazilian local number by area code
-		if gs.isBrazilianAreaCode(number) {
-			// Add Brazilian country code
-			if len(number) == 11 || len(number) == 10 {
-				number = "55" + number
-			}
-		}
-	}
-
-	return number
-}
-
-// removeNinthDigitFromBrazilian removes the 9th digit from Brazilian mobile numbers
-func (gs *GroupService) removeNinthDigitFromBrazilian(number string) string {
-	// Brazilian format: 55 + 2-digit area code + 9-digit mobile number
-	// The 9th digit is the first digit of the mobile number part
-	if strings.HasPrefix(number, "55") && len(number) == 13 {
-		areaCode := number[2:4]
-		mobileNumber := number[4:]
-
-		// Check if it starts with 9 (mobile number indicator)
-		if strings.HasPrefix(mobileNumber, "9") && len(mobileNumber) == 9 {
-			// Remove the first 9
-			return "55" + areaCode + mobileNumber[1:]
-		}
-	}
-
-	return number
-}
-
-// addNinthDigitToBrazilian adds the 9th digit to Brazilian mobile numbers
-func (gs *GroupService) addNinthDigitToBrazilian(number string) string {
-	// Brazilian format: 55 + 2-digit area code + 8-digit mobile number (old format)
-	if strings.HasPrefix(number, "55") && len(number) == 12 {
-		areaCode := number[2:4]
-		mobileNumber := number[4:]
-
-		// Check if it's a mobile number (starts with 9, 8, 7, or 6) and doesn't already have 9
-		if len(mobileNumber) == 8 && (mobileNumber[0] >= '6' && mobileNumber[0] <= '9') {
-			if !strings.HasPrefix(mobileNumber, "9") {
-				return "55" + areaCode + "9" + mobileNumber
-			}
-		}
-	}
-
-	return number
-}
-
-// checkNumberExistsOnWhatsAppAndGetJID verifies if a number exists on WhatsApp and returns the correct JID
-func (gs *GroupService) checkNumberExistsOnWhatsAppAndGetJID(userID, number string) (string, bool, error) {
-	client, exists := gs.groupManager.GetSession(userID)
-	if !exists {
-		return "", false, fmt.Errorf("sessão não encontrada: %s", userID)
-	}
-
-	// Verificar se o cliente está conectado
-	if !client.IsConnected() {
-		return "", false, fmt.Errorf("cliente não está conectado")
-	}
-
-	// Format with + for WhatsApp API
-	numberWithPlus := "+" + number
-
-	// Check status
-	responses, err := client.GetWAClient().IsOnWhatsApp([]string{numberWithPlus})
-	if err != nil {
-		return "", false, fmt.Errorf("falha ao verificar número: %w", err)
-	}
-
-	if len(responses) == 0 {
-		return "", false, nil
-	}
-
-	response := responses[0]
-	
-	// Log the response for debugging
-	logger.Debug("IsOnWhatsApp response", "query", response.Query, "jid", response.JID.String(), "is_in", response.IsIn)
-
-	if response.IsIn {
-		return response.JID.String(), true, nil
-	}
-
-	return "", false, nil
-}
-
-// checkNumberExistsOnWhatsApp verifies if a number exists on WhatsApp (legacy function for compatibility)
-func (gs *GroupService) checkNumberExistsOnWhatsApp(userID, number string) (bool, error) {
-	_, exists, err := gs.checkNumberExistsOnWhatsAppAndGetJID(userID, number)
-	return exists, err
-}
-
-// isBrazilianAreaCode checks if a number starts with a valid Brazilian area code
-func (gs *GroupService) isBrazilianAreaCode(number string) bool {
-	if len(number) < 2 {
-		return false
-	}
-
-	// Valid Brazilian area codes (11-99, but not all combinations)
-	areaCode := number[:2]
-
-	// List of valid Brazilian area codes
-	validAreaCodes := map[string]bool{
-		"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
-		"21": true, "22": true, "24": true,
-		"27": true, "28": true,
-		"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
-		"41": true, "42": true, "43": true, "44": true, "45": true, "46": true,
-		"47": true, "48": true, "49": true,
-		"51": true, "53": true, "54": true, "55": true,
-		"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
-		"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
-		"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
-		"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true,
-	}
-
-	// For numbers starting with valid area codes, do additional validation
-	if validAreaCodes[areaCode] {
-		// Additional check: if the number is 11 digits and starts with a typical US pattern (like 1555...),
-		// it's probably not Brazilian
-		if len(number) == 11 && strings.HasPrefix(number, "1555") {
-			return false
-		}
-
-		// Additional check: if the number is 10-11 digits starting with "15" and followed by "55",
-		// it's probably a US number (1-555-...)
-		if areaCode == "15" && len(number) >= 4 && number[2:4] == "55" {
-			return false
-		}
-
-		return true
-	}
-
-	return false
+	return gs.resolveParticipantJID(userID, waClient, phoneNumber)
 }
 
 // Group permission methods
@@ -1408,3 +1281,151 @@ func (gs *GroupService) SetGroupMemberAddMode(userID, groupJID, mode string) err
 
 	return nil
 }
+
+// Group join request methods
+
+// GetGroupJoinRequests lista as solicitações pendentes de entrada no grupo
+func (gs *GroupService) GetGroupJoinRequests(userID, groupJID string) (interface{}, error) {
+	client, exists := gs.groupManager.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.IsConnected() {
+		return nil, fmt.Errorf("sessão não conectada: %s", userID)
+	}
+
+	// Atualizar atividade
+	client.UpdateActivity()
+
+	// Converter para JID do grupo
+	groupID, err := types.ParseJID(groupJID)
+	if err != nil {
+		return nil, fmt.Errorf("JID de grupo inválido: %w", err)
+	}
+
+	// Verificar se é realmente um grupo
+	if groupID.Server != types.GroupServer {
+		return nil, fmt.Errorf("JID não é um grupo: %s", groupJID)
+	}
+
+	// Obter solicitações pendentes
+	waRequests, err := client.GetWAClient().GetGroupRequestParticipants(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter solicitações de entrada do grupo: %w", err)
+	}
+
+	requests := make([]GroupJoinRequest, 0, len(waRequests))
+	for _, request := range waRequests {
+		requests = append(requests, GroupJoinRequest{
+			JID:         request.JID.String(),
+			RequestedAt: request.RequestedAt,
+		})
+	}
+
+	// Log
+	logger.Debug("Solicitações de entrada do grupo obtidas",
+		"user_id", userID,
+		"group_jid", groupJID,
+		"requests_count", len(requests))
+
+	return requests, nil
+}
+
+// UpdateGroupJoinRequests aprova ou rejeita solicitações de entrada no grupo.
+// Com participants vazio, a ação é aplicada a todas as solicitações pendentes.
+func (gs *GroupService) UpdateGroupJoinRequests(userID, groupJID string, participants []string, action string) (interface{}, error) {
+	client, exists := gs.groupManager.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.IsConnected() {
+		return nil, fmt.Errorf("sessão não conectada: %s", userID)
+	}
+
+	// Atualizar atividade
+	client.UpdateActivity()
+
+	// Converter ação para o tipo adequado
+	var change whatsmeow.ParticipantRequestChange
+	switch action {
+	case "approve":
+		change = whatsmeow.ParticipantChangeApprove
+	case "reject":
+		change = whatsmeow.ParticipantChangeReject
+	default:
+		return nil, fmt.Errorf("ação inválida: %s (deve ser 'approve' ou 'reject')", action)
+	}
+
+	// Converter para JID do grupo
+	groupID, err := types.ParseJID(groupJID)
+	if err != nil {
+		return nil, fmt.Errorf("JID de grupo inválido: %w", err)
+	}
+
+	// Verificar se é realmente um grupo
+	if groupID.Server != types.GroupServer {
+		return nil, fmt.Errorf("JID não é um grupo: %s", groupJID)
+	}
+
+	result := &GroupUpdateResult{
+		SuccessfulUpdates: []string{},
+		FailedUpdates:     make(map[string]string),
+	}
+
+	// Converter JIDs dos participantes com validação
+	var jids []types.JID
+	if len(participants) == 0 {
+		pending, err := client.GetWAClient().GetGroupRequestParticipants(groupID)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao obter solicitações de entrada do grupo: %w", err)
+		}
+		for _, request := range pending {
+			jids = append(jids, request.JID)
+		}
+	} else {
+		for _, p := range participants {
+			validatedJID, err := gs.validateAndProcessParticipantNumber(userID, p)
+			if err != nil {
+				result.FailedUpdates[p] = err.Error()
+				continue
+			}
+
+			jid, err := types.ParseJID(validatedJID)
+			if err != nil {
+				result.FailedUpdates[p] = fmt.Sprintf("JID inválido: %v", err)
+				continue
+			}
+			jids = append(jids, jid)
+		}
+	}
+
+	if len(jids) == 0 {
+		return result, nil
+	}
+
+	// Aprovar ou rejeitar as solicitações
+	updated, err := client.GetWAClient().UpdateGroupRequestParticipants(groupID, jids, change)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao atualizar solicitações de entrada do grupo: %w", err)
+	}
+
+	for _, participant := range updated {
+		if participant.Error != 0 {
+			result.FailedUpdates[participant.JID.String()] = fmt.Sprintf("erro %d", participant.Error)
+			continue
+		}
+		result.SuccessfulUpdates = append(result.SuccessfulUpdates, participant.JID.String())
+	}
+
+	// Log
+	logger.Debug("Solicitações de entrada do grupo atualizadas",
+		"user_id", userID,
+		"group_jid", groupJID,
+		"action", action,
+		"successful", len(result.SuccessfulUpdates),
+		"failed", len(result.FailedUpdates))
+
+	return result, nil
+}
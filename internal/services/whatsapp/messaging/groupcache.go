@@ -0,0 +1,130 @@
+// internal/services/whatsapp/messaging/groupcache.go
+package messaging
+
+import (
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// GroupCache guarda os metadados dos grupos para evitar consultas ao WhatsApp a cada leitura
+type GroupCache interface {
+	GetCachedGroup(userID, groupJID string) (*storage.CachedGroup, error)
+	ListCachedGroups(userID string) ([]storage.CachedGroup, bool, error)
+	SaveCachedGroup(userID string, info *types.GroupInfo) error
+	SyncCachedGroups(userID string, groups []*types.GroupInfo) error
+	DeleteCachedGroup(userID, groupJID string) error
+}
+
+// SetGroupCache define o cache de metadados dos grupos
+func (gs *GroupService) SetGroupCache(cache GroupCache) {
+	gs.groupCache = cache
+}
+
+// loadGroupInfo retorna os metadados do grupo, usando o cache quando fresh for falso.
+// Consultas ao WhatsApp atualizam o cache; o horário retornado é o da cópia em cache, ou nil se a consulta foi ao vivo.
+func (gs *GroupService) loadGroupInfo(userID string, waClient *whatsmeow.Client, groupID types.JID, fresh bool) (*types.GroupInfo, *time.Time, error) {
+	if !fresh && gs.groupCache != nil {
+		cached, err := gs.groupCache.GetCachedGroup(userID, groupID.String())
+		if err != nil {
+			// Sem cache a consulta segue direto para o WhatsApp
+			logger.Warn("Falha ao consultar cache de grupos", "user_id", userID, "group_jid", groupID.String(), "error", err)
+		} else if cached != nil {
+			return &cached.Info, &cached.UpdatedAt, nil
+		}
+	}
+
+	info, err := waClient.GetGroupInfo(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gs.groupCache != nil {
+		if err := gs.groupCache.SaveCachedGroup(userID, info); err != nil {
+			logger.Warn("Falha ao salvar grupo em cache", "user_id", userID, "group_jid", groupID.String(), "error", err)
+		}
+	}
+
+	return info, nil, nil
+}
+
+// loadJoinedGroups retorna os grupos da sessão, usando o cache quando fresh for falso.
+// O cache só é usado depois de uma sincronização completa, para não omitir grupos ainda não consultados.
+func (gs *GroupService) loadJoinedGroups(userID string, waClient *whatsmeow.Client, fresh bool) ([]*types.GroupInfo, []*time.Time, error) {
+	if !fresh && gs.groupCache != nil {
+		cached, complete, err := gs.groupCache.ListCachedGroups(userID)
+		if err != nil {
+			logger.Warn("Falha ao consultar cache de grupos", "user_id", userID, "error", err)
+		} else if complete {
+			groups := make([]*types.GroupInfo, len(cached))
+			cachedAt := make([]*time.Time, len(cached))
+			for i := range cached {
+				groups[i] = &cached[i].Info
+				cachedAt[i] = &cached[i].UpdatedAt
+			}
+			return groups, cachedAt, nil
+		}
+	}
+
+	groups, err := gs.syncGroupCache(userID, waClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, make([]*time.Time, len(groups)), nil
+}
+
+// syncGroupCache consulta todos os grupos da sessão no WhatsApp e substitui o cache
+func (gs *GroupService) syncGroupCache(userID string, waClient *whatsmeow.Client) ([]*types.GroupInfo, error) {
+	groups, err := waClient.GetJoinedGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	if gs.groupCache != nil {
+		if err := gs.groupCache.SyncCachedGroups(userID, groups); err != nil {
+			logger.Warn("Falha ao sincronizar cache de grupos", "user_id", userID, "error", err)
+		}
+	}
+
+	return groups, nil
+}
+
+// SyncGroupCache atualiza o cache com a lista completa de grupos da sessão
+func (gs *GroupService) SyncGroupCache(userID string) (int, error) {
+	client, exists := gs.groupManager.GetSession(userID)
+	if !exists {
+		return 0, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.IsConnected() {
+		return 0, fmt.Errorf("sessão não conectada: %s", userID)
+	}
+
+	groups, err := gs.syncGroupCache(userID, client.GetWAClient())
+	if err != nil {
+		return 0, fmt.Errorf("falha ao obter lista de grupos: %w", err)
+	}
+
+	logger.Debug("Cache de grupos sincronizado",
+		"user_id", userID,
+		"groups_count", len(groups))
+
+	return len(groups), nil
+}
+
+// forgetCachedGroup remove do cache um grupo do qual a sessão saiu
+func (gs *GroupService) forgetCachedGroup(userID, groupJID string) {
+	if gs.groupCache == nil {
+		return
+	}
+
+	if err := gs.groupCache.DeleteCachedGroup(userID, groupJID); err != nil {
+		logger.Warn("Falha ao remover grupo do cache", "user_id", userID, "group_jid", groupJID, "error", err)
+	}
+}
@@ -0,0 +1,70 @@
+// internal/services/whatsapp/messaging/groupexport.go
+package messaging
+
+import (
+	"strings"
+)
+
+// Papéis de um participante na exportação
+const (
+	ParticipantRoleSuperAdmin = "superadmin"
+	ParticipantRoleAdmin      = "admin"
+	ParticipantRoleMember     = "member"
+)
+
+// GroupParticipantRow representa uma linha da exportação de participantes
+type GroupParticipantRow struct {
+	GroupJID    string `json:"group_jid"`
+	GroupName   string `json:"group_name"`
+	JID         string `json:"jid"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	LID         string `json:"lid,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Role        string `json:"role"`
+}
+
+// FilterAdminGroups retorna apenas os grupos em que a sessão é administradora
+func FilterAdminGroups(groups []*GroupInfo) []*GroupInfo {
+	adminGroups := make([]*GroupInfo, 0, len(groups))
+	for _, group := range groups {
+		// As permissões já consideram o JID e o LID da sessão
+		if group.UserPermissions.IsAdmin || group.UserPermissions.IsSuperAdmin {
+			adminGroups = append(adminGroups, group)
+		}
+	}
+	return adminGroups
+}
+
+// GroupParticipantRows converte os participantes do grupo em linhas de exportação
+func GroupParticipantRows(group *GroupInfo) []GroupParticipantRow {
+	rows := make([]GroupParticipantRow, len(group.Participants))
+	for i, p := range group.Participants {
+		row := GroupParticipantRow{
+			GroupJID:    group.JID,
+			GroupName:   group.Name,
+			JID:         p.JID,
+			LID:         p.LID,
+			DisplayName: p.DisplayName,
+			Role:        ParticipantRoleMember,
+		}
+
+		// O número sai só com os dígitos; em grupos sem LID ele é o próprio JID
+		phoneJID := p.PhoneNumber
+		if phoneJID == "" && strings.HasSuffix(p.JID, "@s.whatsapp.net") {
+			phoneJID = p.JID
+		}
+		if user, _, found := strings.Cut(phoneJID, "@"); found {
+			row.PhoneNumber = user
+		}
+
+		switch {
+		case p.IsSuperAdmin:
+			row.Role = ParticipantRoleSuperAdmin
+		case p.IsAdmin:
+			row.Role = ParticipantRoleAdmin
+		}
+
+		rows[i] = row
+	}
+	return rows
+}
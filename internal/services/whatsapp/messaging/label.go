@@ -0,0 +1,206 @@
+// internal/services/whatsapp/messaging/label.go
+package messaging
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// MaxLabelColor é o maior índice de cor aceito pelo WhatsApp para etiquetas
+const MaxLabelColor = 19
+
+// LabelStore guarda as etiquetas do WhatsApp Business da sessão
+type LabelStore interface {
+	GetLabel(userID, labelID string) (*storage.Label, error)
+	NextLabelID(userID string) (string, error)
+	SaveLabel(userID string, label storage.Label) error
+	DeleteLabel(userID, labelID string) error
+	SetChatLabel(userID, labelID, chatJID string, labeled bool) error
+	SetMessageLabel(userID, labelID, chatJID, messageID string, labeled bool) error
+}
+
+// SetLabelStore define onde as etiquetas são guardadas
+func (ms *MessageService) SetLabelStore(store LabelStore) {
+	ms.labelStore = store
+}
+
+// CreateLabel cria uma etiqueta com o próximo ID livre da conta
+func (ms *MessageService) CreateLabel(userID, name string, color int32) (interface{}, error) {
+	if err := validateLabel(name, color); err != nil {
+		return nil, err
+	}
+	if ms.labelStore == nil {
+		return nil, fmt.Errorf("armazenamento de etiquetas não configurado")
+	}
+
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Os IDs são sequenciais; as etiquetas existentes chegam pela sincronização de app state
+	labelID, err := ms.labelStore.NextLabelID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao gerar ID da etiqueta: %w", err)
+	}
+
+	label := storage.Label{ID: labelID, Name: strings.TrimSpace(name), Color: color}
+	if err := sendChatPatch(waClient, appstate.BuildLabelEdit(label.ID, label.Name, label.Color, false)); err != nil {
+		return nil, fmt.Errorf("falha ao criar etiqueta: %w", err)
+	}
+
+	ms.updateLabelStore(userID, label.ID, func(store LabelStore) error {
+		return store.SaveLabel(userID, label)
+	})
+
+	logger.Debug("Etiqueta criada", "user_id", userID, "label_id", label.ID, "name", label.Name)
+
+	return &label, nil
+}
+
+// EditLabel altera o nome e a cor de uma etiqueta
+func (ms *MessageService) EditLabel(userID, labelID, name string, color int32) (interface{}, error) {
+	if err := validateLabel(name, color); err != nil {
+		return nil, err
+	}
+
+	waClient, label, err := ms.labelTarget(userID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	label.Name = strings.TrimSpace(name)
+	label.Color = color
+	if err := sendChatPatch(waClient, appstate.BuildLabelEdit(label.ID, label.Name, label.Color, false)); err != nil {
+		return nil, fmt.Errorf("falha ao editar etiqueta: %w", err)
+	}
+
+	ms.updateLabelStore(userID, label.ID, func(store LabelStore) error {
+		return store.SaveLabel(userID, *label)
+	})
+
+	logger.Debug("Etiqueta editada", "user_id", userID, "label_id", label.ID, "name", label.Name)
+
+	return label, nil
+}
+
+// DeleteLabel apaga uma etiqueta, removendo-a de todas as conversas e mensagens
+func (ms *MessageService) DeleteLabel(userID, labelID string) error {
+	waClient, label, err := ms.labelTarget(userID, labelID)
+	if err != nil {
+		return err
+	}
+
+	if err := sendChatPatch(waClient, appstate.BuildLabelEdit(label.ID, label.Name, label.Color, true)); err != nil {
+		return fmt.Errorf("falha ao apagar etiqueta: %w", err)
+	}
+
+	ms.updateLabelStore(userID, label.ID, func(store LabelStore) error {
+		return store.DeleteLabel(userID, label.ID)
+	})
+
+	logger.Debug("Etiqueta apagada", "user_id", userID, "label_id", label.ID)
+
+	return nil
+}
+
+// LabelChat adiciona ou remove uma etiqueta de uma conversa
+func (ms *MessageService) LabelChat(userID, chat, labelID string, labeled bool) error {
+	if strings.TrimSpace(labelID) == "" {
+		return fmt.Errorf("ID da etiqueta não pode estar vazio")
+	}
+
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	if err := sendChatPatch(waClient, appstate.BuildLabelChat(target, labelID, labeled)); err != nil {
+		return fmt.Errorf("falha ao etiquetar conversa: %w", err)
+	}
+
+	ms.updateLabelStore(userID, labelID, func(store LabelStore) error {
+		return store.SetChatLabel(userID, labelID, target.String(), labeled)
+	})
+
+	logger.Debug("Conversa etiquetada", "user_id", userID, "chat", target.String(), "label_id", labelID, "labeled", labeled)
+
+	return nil
+}
+
+// LabelMessage adiciona ou remove uma etiqueta de uma mensagem
+func (ms *MessageService) LabelMessage(userID, chat, messageID, labelID string, labeled bool) error {
+	if strings.TrimSpace(labelID) == "" {
+		return fmt.Errorf("ID da etiqueta não pode estar vazio")
+	}
+	if strings.TrimSpace(messageID) == "" {
+		return fmt.Errorf("ID da mensagem não pode estar vazio")
+	}
+
+	waClient, target, err := ms.chatTarget(userID, chat)
+	if err != nil {
+		return err
+	}
+
+	if err := sendChatPatch(waClient, appstate.BuildLabelMessage(target, labelID, messageID, labeled)); err != nil {
+		return fmt.Errorf("falha ao etiquetar mensagem: %w", err)
+	}
+
+	ms.updateLabelStore(userID, labelID, func(store LabelStore) error {
+		return store.SetMessageLabel(userID, labelID, target.String(), messageID, labeled)
+	})
+
+	logger.Debug("Mensagem etiquetada", "user_id", userID, "chat", target.String(), "message_id", messageID,
+		"label_id", labelID, "labeled", labeled)
+
+	return nil
+}
+
+// labelTarget retorna o cliente da sessão e a etiqueta armazenada com o ID informado
+func (ms *MessageService) labelTarget(userID, labelID string) (*whatsmeow.Client, *storage.Label, error) {
+	if ms.labelStore == nil {
+		return nil, nil, fmt.Errorf("armazenamento de etiquetas não configurado")
+	}
+
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	label, err := ms.labelStore.GetLabel(userID, labelID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha ao consultar etiqueta: %w", err)
+	}
+	if label == nil {
+		return nil, nil, fmt.Errorf("etiqueta não encontrada: %s", labelID)
+	}
+
+	return waClient, label, nil
+}
+
+// updateLabelStore aplica a mudança nas etiquetas armazenadas; os eventos de app state também a aplicam ao chegar
+func (ms *MessageService) updateLabelStore(userID, labelID string, update func(store LabelStore) error) {
+	if ms.labelStore == nil {
+		return
+	}
+	if err := update(ms.labelStore); err != nil {
+		logger.Warn("Falha ao atualizar etiqueta armazenada", "user_id", userID, "label_id", labelID, "error", err)
+	}
+}
+
+// validateLabel verifica o nome e a cor de uma etiqueta
+func validateLabel(name string, color int32) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("nome da etiqueta não pode estar vazio")
+	}
+	if color < 0 || color > MaxLabelColor {
+		return fmt.Errorf("cor da etiqueta deve estar entre 0 e %d", MaxLabelColor)
+	}
+	return nil
+}
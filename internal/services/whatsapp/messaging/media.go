@@ -0,0 +1,104 @@
+// internal/services/whatsapp/messaging/media.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/pkg/logger"
+)
+
+// Prazos do download da mídia e do upload para o WhatsApp
+const (
+	mediaDownloadTimeout = 30 * time.Second
+	mediaUploadTimeout   = 60 * time.Second
+)
+
+// uploadedMedia é uma mídia baixada de uma URL e enviada aos servidores do WhatsApp
+type uploadedMedia struct {
+	mediaType whatsmeow.MediaType
+	data      []byte
+	mimetype  string
+	upload    whatsmeow.UploadResponse
+}
+
+// uploadMediaFromURL baixa a mídia da URL e faz o upload para o WhatsApp
+func uploadMediaFromURL(waClient *whatsmeow.Client, mediaURL string, mediaType whatsmeow.MediaType) (*uploadedMedia, error) {
+	// Fazer download da mídia da URL
+	logger.Debug("Baixando mídia da URL", "url", mediaURL)
+	httpClient := &http.Client{
+		Timeout: mediaDownloadTimeout,
+	}
+	resp, err := httpClient.Get(mediaURL)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao baixar mídia da URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao baixar mídia da URL: status %d", resp.StatusCode)
+	}
+
+	fileData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler conteúdo da resposta: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), mediaUploadTimeout)
+	defer cancel()
+
+	uploadResp, err := waClient.Upload(ctx, fileData, mediaType)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao fazer upload: %w", err)
+	}
+
+	mimetype := resp.Header.Get("Content-Type")
+	if mimetype == "" {
+		mimetype = http.DetectContentType(fileData)
+	}
+
+	return &uploadedMedia{
+		mediaType: mediaType,
+		data:      fileData,
+		mimetype:  mimetype,
+		upload:    uploadResp,
+	}, nil
+}
+
+// visualMessage monta a mensagem de imagem ou vídeo da mídia enviada
+func (m *uploadedMedia) visualMessage(caption string) *waE2E.Message {
+	if m.mediaType == whatsmeow.MediaVideo {
+		return &waE2E.Message{
+			VideoMessage: &waE2E.VideoMessage{
+				Caption:       proto.String(caption),
+				Mimetype:      proto.String(m.mimetype),
+				URL:           &m.upload.URL,
+				DirectPath:    &m.upload.DirectPath,
+				MediaKey:      m.upload.MediaKey,
+				FileEncSHA256: m.upload.FileEncSHA256,
+				FileSHA256:    m.upload.FileSHA256,
+				FileLength:    &m.upload.FileLength,
+			},
+		}
+	}
+
+	return &waE2E.Message{
+		ImageMessage: &waE2E.ImageMessage{
+			Caption:       proto.String(caption),
+			Mimetype:      proto.String(m.mimetype),
+			URL:           &m.upload.URL,
+			DirectPath:    &m.upload.DirectPath,
+			MediaKey:      m.upload.MediaKey,
+			FileEncSHA256: m.upload.FileEncSHA256,
+			FileSHA256:    m.upload.FileSHA256,
+			FileLength:    &m.upload.FileLength,
+		},
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"yourproject/internal/services/whatsapp/session"
 	"yourproject/internal/services/whatsapp/worker"
 	"yourproject/internal/services/whatsapp/extensions"
+	"yourproject/internal/services/whatsapp/phone"
 	"yourproject/pkg/logger"
 
 	"go.mau.fi/whatsmeow"
@@ -30,7 +31,13 @@ import (
 
 // MessageService provides messaging functionality and implements worker.MessageServiceInterface
 type MessageService struct {
-	sessionManager session.Manager
+	phoneResolver
+	sessionManager     session.Manager
+	suppressionChecker SuppressionChecker
+	profileCache       ContactProfileCache
+	profileCacheTTL    time.Duration
+	chatStore          ChatStore
+	labelStore         LabelStore
 }
 
 // NewMessageService creates a new message service
@@ -59,11 +66,6 @@ func (ms *MessageService) ValidateAndOrganizeRecipient(userID, to string) (strin
 		return ms.validateSpecialJID(to)
 	}
 
-	// If it's a phone number, validate and process it
-	if ms.isPhoneNumber(to) {
-		return ms.validateAndProcessPhoneNumber(userID, to)
-	}
-
 	// If it already contains @, try to parse as-is
 	if strings.Contains(to, "@") {
 		_, err := types.ParseJID(to)
@@ -73,8 +75,8 @@ func (ms *MessageService) ValidateAndOrganizeRecipient(userID, to string) (strin
 		return to, nil
 	}
 
-	// Default: treat as phone number and add @s.whatsapp.net
-	return ms.validateAndProcessPhoneNumber(userID, to)
+	// Phone number: normalize with the session region and resolve the registered JID
+	return ms.resolvePhoneJID(userID, ms.connectedWAClient(userID), to)
 }
 
 // validateSpecialJID validates JIDs for groups, newsletters, broadcasters, etc.
@@ -87,211 +89,36 @@ func (ms *MessageService) validateSpecialJID(jid string) (string, error) {
 	return jid, nil
 }
 
-// isPhoneNumber checks if the input looks like a phone number
-func (ms *MessageService) isPhoneNumber(input string) bool {
-	// Remove common phone number characters
-	cleaned := strings.ReplaceAll(input, "+", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-
-	// Check if all remaining characters are digits
-	for _, r := range cleaned {
-		if r < '0' || r > '9' {
-			return false
-		}
-	}
-
-	// Must have at least 10 digits for a valid phone number
-	return len(cleaned) >= 10
-}
-
-// validateAndProcessPhoneNumber processes and validates phone numbers
-func (ms *MessageService) validateAndProcessPhoneNumber(userID, phoneNumber string) (string, error) {
-	// Clean the phone number
-	cleaned := ms.cleanPhoneNumber(phoneNumber)
-
-	// Handle Brazilian numbers with the 9-digit rule
-	processed := ms.processBrazilianNumber(cleaned)
-
-	// Check if the number exists on WhatsApp and get the correct JID
-	jid, exists, err := ms.checkNumberExistsOnWhatsAppAndGetJID(userID, processed)
-	if err != nil {
-		logger.Debug("Erro ao verificar número no WhatsApp", "number", processed, "error", err)
-		// Continue even if verification fails, but log the error
-	}
-
-	if !exists {
-		// Try with the alternative format for Brazilian numbers
-		if strings.HasPrefix(processed, "55") && len(processed) >= 12 {
-			var alternatives []string
-
-			if len(processed) == 13 {
-				// 13 digits: try removing the 9
-				alternative := ms.removeNinthDigitFromBrazilian(processed)
-				if alternative != processed {
-					alternatives = append(alternatives, alternative)
-				}
-			} else if len(processed) == 12 {
-				// 12 digits: try adding the 9
-				alternative := ms.addNinthDigitToBrazilian(processed)
-				if alternative != processed {
-					alternatives = append(alternatives, alternative)
-				}
-			} else if len(processed) == 11 {
-				// 11 digits: This could be a landline or mobile without country code added incorrectly
-				// Try to re-process as 9-digit number by adding country code
-				withoutCountryCode := processed[2:] // Remove "55"
-				if len(withoutCountryCode) == 9 {
-					// Add 55 back and try both with and without 9
-					alternatives = append(alternatives, "55"+withoutCountryCode) // As-is
-
-					// Try adding 9 if it doesn't start with 9
-					if !strings.HasPrefix(withoutCountryCode, "9") {
-						alternatives = append(alternatives, "55"+withoutCountryCode[:2]+"9"+withoutCountryCode[2:])
-					}
-
-					// Try removing 9 if it starts with 9
-					if strings.HasPrefix(withoutCountryCode, "9") && len(withoutCountryCode) == 9 {
-						alternatives = append(alternatives, "55"+withoutCountryCode[1:])
-					}
-				}
-			}
-
-			// Test each alternative
-			for _, alt := range alternatives {
-				altJID, altExists, altErr := ms.checkNumberExistsOnWhatsAppAndGetJID(userID, alt)
-				if altErr == nil && altExists {
-					return altJID, nil
-				}
-			}
-		}
-	}
-
-	if exists && jid != "" {
-		return jid, nil
-	}
-
-	// If no valid JID found, fallback to manual construction
-	fallbackJID := processed + "@s.whatsapp.net"
-	logger.Debug("Usando JID de fallback", "number", processed, "jid", fallbackJID)
-	return fallbackJID, nil
-}
-
-// cleanPhoneNumber removes formatting characters from phone number
-func (ms *MessageService) cleanPhoneNumber(phone string) string {
-	// Remove all non-digit characters except +
-	cleaned := strings.ReplaceAll(phone, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-	cleaned = strings.ReplaceAll(cleaned, ".", "")
-
-	// Remove + if present
-	cleaned = strings.TrimPrefix(cleaned, "+")
-
-	return cleaned
-}
-
-// processBrazilianNumber handles Brazilian number formatting
-func (ms *MessageService) processBrazilianNumber(number string) string {
-	// If it doesn't start with country code, check if it's a Brazilian local number
-	if !strings.HasPrefix(number, "55") && len(number) >= 10 && len(number) <= 11 {
-		// Check if it looks like a Brazilian local number by area code
-		if isBrazilianAreaCode(number) {
-			// Add Brazilian country code
-			if len(number) == 11 || len(number) == 10 {
-				number = "55" + number
-			}
-		}
-	}
-
-	return number
-}
-
-// removeNinthDigitFromBrazilian removes the 9th digit from Brazilian mobile numbers
-func (ms *MessageService) removeNinthDigitFromBrazilian(number string) string {
-	// Brazilian format: 55 + 2-digit area code + 9-digit mobile number
-	// The 9th digit is the first digit of the mobile number part
-	if strings.HasPrefix(number, "55") && len(number) == 13 {
-		areaCode := number[2:4]
-		mobileNumber := number[4:]
-
-		// Check if it starts with 9 (mobile number indicator)
-		if strings.HasPrefix(mobileNumber, "9") && len(mobileNumber) == 9 {
-			// Remove the first 9
-			return "55" + areaCode + mobileNumber[1:]
-		}
+// connectedWAClient returns the WhatsApp client of the session, or nil if it is not connected
+func (ms *MessageService) connectedWAClient(userID string) *whatsmeow.Client {
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists || !client.Connected {
+		return nil
 	}
-
-	return number
+	return client.WAClient
 }
 
-// addNinthDigitToBrazilian adds the 9th digit to Brazilian mobile numbers
-func (ms *MessageService) addNinthDigitToBrazilian(number string) string {
-	// Brazilian format: 55 + 2-digit area code + 8-digit mobile number (old format)
-	if strings.HasPrefix(number, "55") && len(number) == 12 {
-		areaCode := number[2:4]
-		mobileNumber := number[4:]
-
-		// Check if it's a mobile number (starts with 9, 8, 7, or 6) and doesn't already have 9
-		if len(mobileNumber) == 8 && (mobileNumber[0] >= '6' && mobileNumber[0] <= '9') {
-			if !strings.HasPrefix(mobileNumber, "9") {
-				return "55" + areaCode + "9" + mobileNumber
-			}
-		}
-	}
-
-	return number
-}
-
-// checkNumberExistsOnWhatsAppAndGetJID verifies if a number exists on WhatsApp and returns the correct JID
-func (ms *MessageService) checkNumberExistsOnWhatsAppAndGetJID(userID, number string) (string, bool, error) {
+// CheckNumberExistsOnWhatsApp verifies if a number exists on WhatsApp (public method)
+func (ms *MessageService) CheckNumberExistsOnWhatsApp(userID, number string) (bool, error) {
 	client, exists := ms.sessionManager.GetSession(userID)
 	if !exists {
-		return "", false, fmt.Errorf("sessão não encontrada: %s", userID)
+		return false, fmt.Errorf("sessão não encontrada: %s", userID)
 	}
 
 	// Verificar se o cliente está conectado
 	if !client.Connected {
-		return "", false, fmt.Errorf("cliente não está conectado")
+		return false, fmt.Errorf("cliente não está conectado")
 	}
 
-	// Format with + for WhatsApp API
-	numberWithPlus := "+" + number
-
-	// Check status
-	responses, err := client.WAClient.IsOnWhatsApp([]string{numberWithPlus})
+	parsed, err := ms.parsePhone(userID, number)
 	if err != nil {
-		return "", false, fmt.Errorf("falha ao verificar número: %w", err)
-	}
-
-	if len(responses) == 0 {
-		return "", false, nil
+		return false, err
 	}
 
-	response := responses[0]
-	logger.Debug("IsOnWhatsApp response", "query", response.Query, "jid", response.JID.String(), "is_in", response.IsIn)
-
-	if response.IsIn {
-		return response.JID.String(), true, nil
-	}
-
-	return "", false, nil
-}
-
-// checkNumberExistsOnWhatsApp verifies if a number exists on WhatsApp (legacy function for compatibility)
-func (ms *MessageService) checkNumberExistsOnWhatsApp(userID, number string) (bool, error) {
-	_, exists, err := ms.checkNumberExistsOnWhatsAppAndGetJID(userID, number)
+	_, exists, err = ms.lookupPhoneJID(userID, client.WAClient, parsed)
 	return exists, err
 }
 
-// CheckNumberExistsOnWhatsApp verifies if a number exists on WhatsApp (public method)
-func (ms *MessageService) CheckNumberExistsOnWhatsApp(userID, number string) (bool, error) {
-	return ms.checkNumberExistsOnWhatsApp(userID, number)
-}
-
 // ParseJID converte uma string para um JID do WhatsApp
 // Deprecated: Use ValidateAndOrganizeRecipient for better validation
 func ParseJID(jid string) (types.JID, error) {
@@ -327,6 +154,11 @@ func (ms *MessageService) SendText(userID, to, message string) (string, error) {
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -337,6 +169,7 @@ func (ms *MessageService) SendText(userID, to, message string) (string, error) {
 	})
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mensagem: %w", err)
 	}
 
@@ -368,15 +201,20 @@ func (ms *MessageService) SendMedia(userID, to, mediaURL, mediaType, caption str
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Check if recipient is a newsletter - handle differently
 	if strings.Contains(validatedJID, "@newsletter") {
-		return ms.sendMediaToNewsletter(userID, validatedJID, mediaURL, mediaType, caption)
+		resp, err := ms.sendMediaToNewsletter(userID, validatedJID, mediaURL, mediaType, caption)
+		if err != nil {
+			return "", err
+		}
+		return resp.ID, nil
 	}
 
-	// Criar contexto com timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
-	defer cancel()
-
 	// Extrair o nome do arquivo da URL
 	fileName := mediaURL
 	if idx := strings.LastIndex(mediaURL, "/"); idx != -1 {
@@ -412,73 +250,28 @@ func (ms *MessageService) SendMedia(userID, to, mediaURL, mediaType, caption str
 		return "", fmt.Errorf("tipo de mídia não suportado: %s", mediaType)
 	}
 
-	// Fazer download da mídia da URL
-	logger.Debug("Baixando mídia da URL", "url", mediaURL)
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-	resp, err := httpClient.Get(mediaURL)
-	if err != nil {
-		return "", fmt.Errorf("falha ao baixar mídia da URL: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("falha ao baixar mídia da URL: status %d", resp.StatusCode)
-	}
-
-	// Ler o conteúdo da resposta
-	fileData, err := io.ReadAll(resp.Body)
+	// Baixar a mídia da URL e fazer upload para o WhatsApp
+	media, err := uploadMediaFromURL(client.WAClient, mediaURL, uploadType)
 	if err != nil {
-		return "", fmt.Errorf("falha ao ler conteúdo da resposta: %w", err)
+		return "", err
 	}
+	uploadResp := media.upload
 
-	// Fazer upload do arquivo para o WhatsApp
-	uploadResp, err := client.WAClient.Upload(ctx, fileData, uploadType)
-	if err != nil {
-		return "", fmt.Errorf("falha ao fazer upload: %w", err)
-	}
+	// Criar contexto com timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
 
 	var msg whatsmeow.SendResponse
 
 	// Enviar conforme o tipo de mídia
 	switch mediaType {
-	case "image", "img":
-		imageMsg := &waE2E.ImageMessage{
-			Caption:       proto.String(caption),
-			Mimetype:      proto.String(resp.Header.Get("Content-Type")),
-			URL:           &uploadResp.URL,
-			DirectPath:    &uploadResp.DirectPath,
-			MediaKey:      uploadResp.MediaKey,
-			FileEncSHA256: uploadResp.FileEncSHA256,
-			FileSHA256:    uploadResp.FileSHA256,
-			FileLength:    &uploadResp.FileLength,
-		}
-
-		msg, err = client.WAClient.SendMessage(ctx, recipient, &waE2E.Message{
-			ImageMessage: imageMsg,
-		})
-
-	case "video", "vid":
-		videoMsg := &waE2E.VideoMessage{
-			Caption:       proto.String(caption),
-			Mimetype:      proto.String(resp.Header.Get("Content-Type")),
-			URL:           &uploadResp.URL,
-			DirectPath:    &uploadResp.DirectPath,
-			MediaKey:      uploadResp.MediaKey,
-			FileEncSHA256: uploadResp.FileEncSHA256,
-			FileSHA256:    uploadResp.FileSHA256,
-			FileLength:    &uploadResp.FileLength,
-		}
-
-		msg, err = client.WAClient.SendMessage(ctx, recipient, &waE2E.Message{
-			VideoMessage: videoMsg,
-		})
+	case "image", "img", "video", "vid":
+		msg, err = client.WAClient.SendMessage(ctx, recipient, media.visualMessage(caption))
 
 	case "audio", "voice":
 		// Calculate audio duration and generate waveform
-		audioDurationInSeconds := getDurationInSeconds(fileData)
-		waveform := generateWaveform(fileData)
+		audioDurationInSeconds := getDurationInSeconds(media.data)
+		waveform := generateWaveform(media.data)
 		
 		audioMsg := &waE2E.AudioMessage{
 			Mimetype:      proto.String("audio/ogg; codecs=opus"),
@@ -501,7 +294,7 @@ func (ms *MessageService) SendMedia(userID, to, mediaURL, mediaType, caption str
 		documentMsg := &waE2E.DocumentMessage{
 			Caption:       proto.String(caption),
 			FileName:      proto.String(fileName),
-			Mimetype:      proto.String(resp.Header.Get("Content-Type")),
+			Mimetype:      proto.String(media.mimetype),
 			URL:           &uploadResp.URL,
 			DirectPath:    &uploadResp.DirectPath,
 			MediaKey:      uploadResp.MediaKey,
@@ -516,6 +309,7 @@ func (ms *MessageService) SendMedia(userID, to, mediaURL, mediaType, caption str
 	}
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mídia: %w", err)
 	}
 
@@ -528,8 +322,9 @@ func (ms *MessageService) SendMedia(userID, to, mediaURL, mediaType, caption str
 	return msg.ID, nil
 }
 
-// sendMediaToNewsletter handles media upload specifically for newsletters using proper WhatsApp newsletter upload method
-func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL, mediaType, caption string) (string, error) {
+// sendMediaToNewsletter handles media upload specifically for newsletters using proper WhatsApp newsletter upload method.
+// The response carries the server ID newsletters assign to each post.
+func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL, mediaType, caption string) (whatsmeow.SendResponse, error) {
 	logger.Debug("Enviando mídia para newsletter",
 		"user_id", userID,
 		"newsletter_jid", newsletterJID,
@@ -539,13 +334,13 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 	// Get client session
 	client, exists := ms.sessionManager.GetSession(userID)
 	if !exists {
-		return "", fmt.Errorf("sessão não encontrada: %s", userID)
+		return whatsmeow.SendResponse{}, fmt.Errorf("sessão não encontrada: %s", userID)
 	}
 
 	// Parse newsletter JID
 	parsedJID, err := types.ParseJID(newsletterJID)
 	if err != nil {
-		return "", fmt.Errorf("JID da newsletter inválido: %w", err)
+		return whatsmeow.SendResponse{}, fmt.Errorf("JID da newsletter inválido: %w", err)
 	}
 
 	// Create context with timeout
@@ -562,7 +357,7 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 	case "audio", "voice":
 		uploadType = whatsmeow.MediaAudio
 	default:
-		return "", fmt.Errorf("newsletters suportam apenas imagens, vídeos e áudios, tipo '%s' não suportado", mediaType)
+		return whatsmeow.SendResponse{}, fmt.Errorf("newsletters suportam apenas imagens, vídeos e áudios, tipo '%s' não suportado", mediaType)
 	}
 
 	// Download the media from URL
@@ -572,12 +367,12 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 	}
 	resp, err := httpClient.Get(mediaURL)
 	if err != nil {
-		return "", fmt.Errorf("falha ao baixar mídia da URL: %w", err)
+		return whatsmeow.SendResponse{}, fmt.Errorf("falha ao baixar mídia da URL: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("falha ao baixar mídia: HTTP %d - %s", resp.StatusCode, resp.Status)
+		return whatsmeow.SendResponse{}, fmt.Errorf("falha ao baixar mídia: HTTP %d - %s", resp.StatusCode, resp.Status)
 	}
 
 	// Validate content type
@@ -606,12 +401,12 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 	// Read the media data
 	mediaData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("falha ao ler dados da mídia: %w", err)
+		return whatsmeow.SendResponse{}, fmt.Errorf("falha ao ler dados da mídia: %w", err)
 	}
 
 	// Validate media data
 	if len(mediaData) == 0 {
-		return "", fmt.Errorf("dados da mídia estão vazios")
+		return whatsmeow.SendResponse{}, fmt.Errorf("dados da mídia estão vazios")
 	}
 
 	logger.Debug("Mídia baixada para newsletter",
@@ -649,7 +444,7 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 
 	uploadResp, err := client.WAClient.UploadNewsletter(ctx, processedData, uploadType)
 	if err != nil {
-		return "", fmt.Errorf("falha ao fazer upload da mídia para newsletter: %w", err)
+		return whatsmeow.SendResponse{}, fmt.Errorf("falha ao fazer upload da mídia para newsletter: %w", err)
 	}
 
 	logger.Debug("Upload da mídia para newsletter concluído",
@@ -713,7 +508,7 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 
 	msg, err := client.WAClient.SendMessage(ctx, parsedJID, message, sendExtra)
 	if err != nil {
-		return "", fmt.Errorf("falha ao enviar mensagem de mídia para newsletter: %w", err)
+		return whatsmeow.SendResponse{}, fmt.Errorf("falha ao enviar mensagem de mídia para newsletter: %w", err)
 	}
 
 	// Update last activity
@@ -726,7 +521,7 @@ func (ms *MessageService) sendMediaToNewsletter(userID, newsletterJID, mediaURL,
 		"message_id", msg.ID,
 		"media_handle", uploadResp.Handle)
 
-	return msg.ID, nil
+	return msg, nil
 }
 
 // SendButtons envia uma mensagem com botões - now using worker types directly
@@ -748,6 +543,11 @@ func (ms *MessageService) SendButtons(userID, to, text, footer string, buttons [
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -783,6 +583,7 @@ func (ms *MessageService) SendButtons(userID, to, text, footer string, buttons [
 	// Enviar mensagem com botões
 	msg, err := client.WAClient.SendMessage(ctx, recipient, message)
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mensagem com botões: %w", err)
 	}
 
@@ -814,6 +615,11 @@ func (ms *MessageService) SendList(userID, to, text, footer, buttonText string,
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -864,6 +670,7 @@ for _, section := range sections {
 	// Enviar mensagem de lista
 	msg, err := client.WAClient.SendMessage(ctx, recipient, message)
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mensagem de lista: %w", err)
 	}
 
@@ -895,6 +702,11 @@ func (ms *MessageService) SendLocation(userID, to string, latitude, longitude fl
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -919,6 +731,7 @@ func (ms *MessageService) SendLocation(userID, to string, latitude, longitude fl
 	})
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mensagem de localização: %w", err)
 	}
 
@@ -950,6 +763,11 @@ func (ms *MessageService) SendContact(userID, to string, contacts []interface{})
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -989,6 +807,7 @@ func (ms *MessageService) SendContact(userID, to string, contacts []interface{})
 		})
 
 		if err != nil {
+			ms.invalidateNumberLookup(userID, recipient.User)
 			return "", fmt.Errorf("falha ao enviar mensagem de contatos: %w", err)
 		}
 
@@ -1007,6 +826,7 @@ func (ms *MessageService) SendContact(userID, to string, contacts []interface{})
 	})
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar mensagem de contato: %w", err)
 	}
 
@@ -1038,6 +858,11 @@ func (ms *MessageService) SendReaction(userID, to, targetJID, targetMessageID, e
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Converter target JID
 	targetJIDParsed, err := ParseJID(targetJID)
 	if err != nil {
@@ -1074,6 +899,7 @@ func (ms *MessageService) SendReaction(userID, to, targetJID, targetMessageID, e
 	})
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar reação: %w", err)
 	}
 
@@ -1105,6 +931,11 @@ func (ms *MessageService) SendPoll(userID, to, name string, options []string, se
 		return "", fmt.Errorf("JID inválido: %w", err)
 	}
 
+	// Recusar destinatários que pediram opt-out
+	if err := ms.checkSuppression(userID, recipient); err != nil {
+		return "", err
+	}
+
 	// Criar contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -1147,6 +978,7 @@ func (ms *MessageService) SendPoll(userID, to, name string, options []string, se
 	})
 
 	if err != nil {
+		ms.invalidateNumberLookup(userID, recipient.User)
 		return "", fmt.Errorf("falha ao enviar enquete: %w", err)
 	}
 
@@ -1182,12 +1014,6 @@ func ValidateRecipientFormat(to string) (string, error) {
 		return to, nil
 	}
 
-	// If it's a phone number, process it
-	if isPhoneNumberFormat(to) {
-		processed := processPhoneNumberFormat(to)
-		return processed + "@s.whatsapp.net", nil
-	}
-
 	// If it already contains @, try to parse as-is
 	if strings.Contains(to, "@") {
 		_, err := types.ParseJID(to)
@@ -1197,114 +1023,13 @@ func ValidateRecipientFormat(to string) (string, error) {
 		return to, nil
 	}
 
-	// If we reach here, it's not a valid phone number or JID
-	return "", fmt.Errorf("formato inválido: deve ser um número de telefone válido ou JID")
-}
-
-// isPhoneNumberFormat checks if the input looks like a phone number (standalone version)
-func isPhoneNumberFormat(input string) bool {
-	// Remove common phone number characters
-	cleaned := strings.ReplaceAll(input, "+", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-
-	// Check if all remaining characters are digits
-	for _, r := range cleaned {
-		if r < '0' || r > '9' {
-			return false
-		}
-	}
-
-	// Must have at least 10 digits for a valid phone number
-	return len(cleaned) >= 10
-}
-
-// processPhoneNumberFormat processes phone number format (standalone version)
-func processPhoneNumberFormat(phoneNumber string) string {
-	// Clean the phone number
-	cleaned := cleanPhoneNumberFormat(phoneNumber)
-
-	// Handle Brazilian numbers with the 9-digit rule
-	processed := processBrazilianNumberFormat(cleaned)
-
-	return processed
-}
-
-// cleanPhoneNumberFormat removes formatting characters from phone number (standalone version)
-func cleanPhoneNumberFormat(phone string) string {
-	// Remove all non-digit characters except +
-	cleaned := strings.ReplaceAll(phone, " ", "")
-	cleaned = strings.ReplaceAll(cleaned, "-", "")
-	cleaned = strings.ReplaceAll(cleaned, "(", "")
-	cleaned = strings.ReplaceAll(cleaned, ")", "")
-	cleaned = strings.ReplaceAll(cleaned, ".", "")
-
-	// Remove + if present
-	cleaned = strings.TrimPrefix(cleaned, "+")
-
-	return cleaned
-}
-
-// processBrazilianNumberFormat handles Brazilian number formatting (standalone version)
-func processBrazilianNumberFormat(number string) string {
-	// If it doesn't start with country code, check if it's a Brazilian local number
-	if !strings.HasPrefix(number, "55") && len(number) >= 10 && len(number) <= 11 {
-		// Check if it looks like a Brazilian local number by area code
-		if isBrazilianAreaCode(number) {
-			// Add Brazilian country code
-			if len(number) == 11 || len(number) == 10 {
-				number = "55" + number
-			}
-		}
-	}
-
-	return number
-}
-
-// isBrazilianAreaCode checks if a number starts with a valid Brazilian area code
-func isBrazilianAreaCode(number string) bool {
-	if len(number) < 2 {
-		return false
-	}
-
-	// Valid Brazilian area codes (11-99, but not all combinations)
-	areaCode := number[:2]
-
-	// List of valid Brazilian area codes
-	validAreaCodes := map[string]bool{
-		"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
-		"21": true, "22": true, "24": true,
-		"27": true, "28": true,
-		"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
-		"41": true, "42": true, "43": true, "44": true, "45": true, "46": true,
-		"47": true, "48": true, "49": true,
-		"51": true, "53": true, "54": true, "55": true,
-		"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
-		"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
-		"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
-		"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true,
-	}
-
-	// For numbers starting with valid area codes, do additional validation
-	if validAreaCodes[areaCode] {
-		// Additional check: if the number is 11 digits and starts with a typical US pattern (like 1555...),
-		// it's probably not Brazilian
-		if len(number) == 11 && strings.HasPrefix(number, "1555") {
-			return false
-		}
-
-		// Additional check: if the number is 10-11 digits starting with "15" and followed by "55",
-		// it's probably a US number (1-555-...)
-		if areaCode == "15" && len(number) >= 4 && number[2:4] == "55" {
-			return false
-		}
-
-		return true
+	// Otherwise it must be a valid phone number
+	number, err := phone.Parse(to, phone.DefaultRegion)
+	if err != nil {
+		return "", err
 	}
 
-	return false
+	return number.JID(), nil
 }
 
 // Helper methods for newsletter media upload
@@ -1,7 +1,17 @@
 package messaging
 
 import (
+	"errors"
+	"reflect"
 	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/internal/storage"
 )
 
 func TestValidateRecipientFormat(t *testing.T) {
@@ -160,8 +170,12 @@ func TestBrazilianNumberProcessing(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := processBrazilianNumberFormat(tt.input)
-			if result != tt.expected {
+			number, err := phone.Parse(tt.input, "BR")
+			if err != nil {
+				t.Errorf("Unexpected error for input %s: %v", tt.input, err)
+				return
+			}
+			if result := number.Digits(); result != tt.expected {
 				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
 			}
 		})
@@ -169,8 +183,6 @@ func TestBrazilianNumberProcessing(t *testing.T) {
 }
 
 func TestRemoveNinthDigitFromBrazilian(t *testing.T) {
-	ms := &MessageService{}
-
 	tests := []struct {
 		name     string
 		input    string
@@ -200,7 +212,7 @@ func TestRemoveNinthDigitFromBrazilian(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ms.removeNinthDigitFromBrazilian(tt.input)
+			result := phone.RemoveBrazilianNinthDigit(tt.input)
 			if result != tt.expected {
 				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
 			}
@@ -209,8 +221,6 @@ func TestRemoveNinthDigitFromBrazilian(t *testing.T) {
 }
 
 func TestAddNinthDigitToBrazilian(t *testing.T) {
-	ms := &MessageService{}
-
 	tests := []struct {
 		name     string
 		input    string
@@ -245,10 +255,592 @@ func TestAddNinthDigitToBrazilian(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ms.addNinthDigitToBrazilian(tt.input)
+			result := phone.AddBrazilianNinthDigit(tt.input)
+			if result != tt.expected {
+				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPhoneNormalizeByRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		region   string
+		expected string
+	}{
+		{
+			name:     "Brazilian number with formatting",
+			input:    "+55 (11) 98837-6411",
+			region:   "BR",
+			expected: "+5511988376411",
+		},
+		{
+			name:     "Brazilian local number with trunk prefix",
+			input:    "011988376411",
+			region:   "BR",
+			expected: "+5511988376411",
+		},
+		{
+			name:     "International prefix 00",
+			input:    "005511988376411",
+			region:   "BR",
+			expected: "+5511988376411",
+		},
+		{
+			name:     "Mexican number in E.164",
+			input:    "+52 55 1234 5678",
+			region:   "BR",
+			expected: "+525512345678",
+		},
+		{
+			name:     "Mexican number with legacy mobile 1",
+			input:    "+5215512345678",
+			region:   "BR",
+			expected: "+525512345678",
+		},
+		{
+			name:     "Mexican local number with 044",
+			input:    "044 55 1234 5678",
+			region:   "MX",
+			expected: "+525512345678",
+		},
+		{
+			name:     "Mexican local number",
+			input:    "5512345678",
+			region:   "MX",
+			expected: "+525512345678",
+		},
+		{
+			name:     "Argentine mobile in international format",
+			input:    "+54 9 11 2345-6789",
+			region:   "BR",
+			expected: "+5491123456789",
+		},
+		{
+			name:     "Argentine mobile dialed locally with 0 and 15",
+			input:    "011 15 2345-6789",
+			region:   "AR",
+			expected: "+5491123456789",
+		},
+		{
+			name:     "Argentine mobile with 3-digit area code and 15",
+			input:    "0351 15 123-4567",
+			region:   "AR",
+			expected: "+5493511234567",
+		},
+		{
+			name:     "Argentine number without 9",
+			input:    "1123456789",
+			region:   "AR",
+			expected: "+541123456789",
+		},
+		{
+			name:     "US number without + in Brazilian session",
+			input:    "15551234567",
+			region:   "BR",
+			expected: "+15551234567",
+		},
+		{
+			name:     "US local number",
+			input:    "(555) 123-4567",
+			region:   "US",
+			expected: "+15551234567",
+		},
+		{
+			name:     "Portuguese number",
+			input:    "+351 912 345 678",
+			region:   "BR",
+			expected: "+351912345678",
+		},
+		{
+			name:     "Country without specific rule",
+			input:    "+81 90 1234 5678",
+			region:   "BR",
+			expected: "+819012345678",
+		},
+		{
+			name:     "No default region",
+			input:    "5511988376411",
+			region:   "",
+			expected: "+5511988376411",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := phone.Normalize(tt.input, tt.region)
+			if err != nil {
+				t.Errorf("Unexpected error for input %s: %v", tt.input, err)
+				return
+			}
+
 			if result != tt.expected {
 				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
 			}
 		})
 	}
 }
+
+func TestPhoneParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		region   string
+		expected phone.ErrorCode
+	}{
+		{
+			name:     "Empty input",
+			input:    "   ",
+			region:   "BR",
+			expected: phone.ErrCodeEmpty,
+		},
+		{
+			name:     "Invalid characters",
+			input:    "abc123",
+			region:   "BR",
+			expected: phone.ErrCodeInvalidCharacters,
+		},
+		{
+			name:     "Too short",
+			input:    "12345",
+			region:   "BR",
+			expected: phone.ErrCodeTooShort,
+		},
+		{
+			name:     "Too long",
+			input:    "+9991234567890123",
+			region:   "BR",
+			expected: phone.ErrCodeTooLong,
+		},
+		{
+			name:     "Mexican number with wrong length",
+			input:    "+52551234567",
+			region:   "BR",
+			expected: phone.ErrCodeInvalidForRegion,
+		},
+		{
+			name:     "Unknown Brazilian area code",
+			input:    "+552012345678",
+			region:   "BR",
+			expected: phone.ErrCodeInvalidForRegion,
+		},
+		{
+			name:     "Brazilian number without area code",
+			input:    "+5588376411",
+			region:   "BR",
+			expected: phone.ErrCodeInvalidForRegion,
+		},
+		{
+			name:     "Incomplete Brazilian number with unknown area code",
+			input:    "+55208837641",
+			region:   "BR",
+			expected: phone.ErrCodeInvalidForRegion,
+		},
+		{
+			name:     "Unsupported default region",
+			input:    "11988376411",
+			region:   "ZZ",
+			expected: phone.ErrCodeUnknownRegion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := phone.Parse(tt.input, tt.region)
+			if err == nil {
+				t.Errorf("Expected error for input %s, but got none", tt.input)
+				return
+			}
+
+			var phoneErr *phone.Error
+			if !errors.As(err, &phoneErr) {
+				t.Errorf("Expected *phone.Error for input %s, got %T", tt.input, err)
+				return
+			}
+
+			if phoneErr.Code != tt.expected {
+				t.Errorf("For input %s, expected code %s, but got %s", tt.input, tt.expected, phoneErr.Code)
+			}
+		})
+	}
+}
+
+func TestPhoneCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		region   string
+		expected []string
+	}{
+		{
+			name:     "Brazilian mobile with 9",
+			input:    "5511988376411",
+			region:   "BR",
+			expected: []string{"5511988376411", "551188376411"},
+		},
+		{
+			name:     "Brazilian mobile without 9",
+			input:    "551188376411",
+			region:   "BR",
+			expected: []string{"551188376411", "5511988376411"},
+		},
+		{
+			name:     "Brazilian landline",
+			input:    "551123456789",
+			region:   "BR",
+			expected: []string{"551123456789"},
+		},
+		{
+			name:     "Incomplete Brazilian number with area code starting with 9",
+			input:    "+55918837641",
+			region:   "BR",
+			expected: []string{"55918837641", "559198837641"},
+		},
+		{
+			name:     "Mexican mobile",
+			input:    "+525512345678",
+			region:   "BR",
+			expected: []string{"525512345678", "5215512345678"},
+		},
+		{
+			name:     "Argentine mobile",
+			input:    "+5491123456789",
+			region:   "BR",
+			expected: []string{"5491123456789", "541123456789"},
+		},
+		{
+			name:     "US number",
+			input:    "+15551234567",
+			region:   "BR",
+			expected: []string{"15551234567"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			number, err := phone.Parse(tt.input, tt.region)
+			if err != nil {
+				t.Errorf("Unexpected error for input %s: %v", tt.input, err)
+				return
+			}
+
+			if result := number.Candidates(); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("For input %s, expected %v, but got %v", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestResolveCachedLookup(t *testing.T) {
+	registered := func(digits string) storage.NumberLookup {
+		return storage.NumberLookup{Phone: digits, JID: digits + "@s.whatsapp.net", Exists: true}
+	}
+	unregistered := func(digits string) storage.NumberLookup {
+		return storage.NumberLookup{Phone: digits}
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		known    []storage.NumberLookup
+		resolved bool
+		exists   bool
+		jid      string
+	}{
+		{
+			name:     "Nothing cached",
+			input:    "5511988376411",
+			resolved: false,
+		},
+		{
+			name:     "Preferred form registered",
+			input:    "5511988376411",
+			known:    []storage.NumberLookup{registered("5511988376411")},
+			resolved: true,
+			exists:   true,
+			jid:      "5511988376411@s.whatsapp.net",
+		},
+		{
+			name:     "Only variant cached as registered",
+			input:    "5511988376411",
+			known:    []storage.NumberLookup{registered("551188376411")},
+			resolved: false,
+		},
+		{
+			name:     "Preferred form unregistered, variant registered",
+			input:    "5511988376411",
+			known:    []storage.NumberLookup{unregistered("5511988376411"), registered("551188376411")},
+			resolved: true,
+			exists:   true,
+			jid:      "551188376411@s.whatsapp.net",
+		},
+		{
+			name:     "Every form unregistered",
+			input:    "5511988376411",
+			known:    []storage.NumberLookup{unregistered("5511988376411"), unregistered("551188376411")},
+			resolved: true,
+			exists:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			number, err := phone.Parse(tt.input, "BR")
+			if err != nil {
+				t.Fatalf("Unexpected error for input %s: %v", tt.input, err)
+			}
+
+			known := make(map[string]storage.NumberLookup)
+			for _, lookup := range tt.known {
+				known[lookup.Phone] = lookup
+			}
+
+			result, resolved := resolveLookup(number, known)
+			if resolved != tt.resolved {
+				t.Fatalf("For input %s, expected resolved=%v, but got %v", tt.input, tt.resolved, resolved)
+			}
+			if result.exists != tt.exists || result.jid != tt.jid {
+				t.Errorf("For input %s, expected (%v, %s), but got (%v, %s)", tt.input, tt.exists, tt.jid, result.exists, result.jid)
+			}
+		})
+	}
+}
+
+func TestParseGroupInviteCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		hasError bool
+	}{
+		{
+			name:     "Full invite link",
+			input:    "https://chat.whatsapp.com/AbCdEf123456",
+			expected: "AbCdEf123456",
+		},
+		{
+			name:     "Invite link with query string",
+			input:    "https://chat.whatsapp.com/AbCdEf123456?mode=ac_t",
+			expected: "AbCdEf123456",
+		},
+		{
+			name:     "Invite code only",
+			input:    " AbCdEf123456 ",
+			expected: "AbCdEf123456",
+		},
+		{
+			name:     "Other domain",
+			input:    "https://example.com/AbCdEf123456",
+			hasError: true,
+		},
+		{
+			name:     "Link without code",
+			input:    "https://chat.whatsapp.com/",
+			hasError: true,
+		},
+		{
+			name:     "Empty input",
+			input:    "",
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseGroupInviteCode(tt.input)
+
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Expected error for input %s, but got none", tt.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %s: %v", tt.input, err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestToGroupInfoSelfPermissions(t *testing.T) {
+	self := types.NewJID("5511987654321", types.DefaultUserServer)
+	selfLID := types.NewJID("123456789012345", types.HiddenUserServer)
+	other := types.NewJID("5511912345678", types.DefaultUserServer)
+
+	tests := []struct {
+		name           string
+		participants   []types.GroupParticipant
+		currentUserJID string
+		isParticipant  bool
+		isAdmin        bool
+	}{
+		{
+			name: "Session JID with device",
+			participants: []types.GroupParticipant{
+				{JID: self, PhoneNumber: self, IsAdmin: true},
+				{JID: other, PhoneNumber: other},
+			},
+			currentUserJID: "5511987654321:12@s.whatsapp.net",
+			isParticipant:  true,
+			isAdmin:        true,
+		},
+		{
+			name: "LID addressed group",
+			participants: []types.GroupParticipant{
+				{JID: selfLID, LID: selfLID, PhoneNumber: self, IsAdmin: true},
+			},
+			currentUserJID: "5511987654321:3@s.whatsapp.net",
+			isParticipant:  true,
+			isAdmin:        true,
+		},
+		{
+			name: "Not a member",
+			participants: []types.GroupParticipant{
+				{JID: other, PhoneNumber: other, IsAdmin: true},
+			},
+			currentUserJID: "5511987654321:12@s.whatsapp.net",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := ToGroupInfo(&types.GroupInfo{Participants: tt.participants}, tt.currentUserJID)
+
+			if info.UserPermissions.IsParticipant != tt.isParticipant {
+				t.Errorf("Expected is_participant %v, but got %v", tt.isParticipant, info.UserPermissions.IsParticipant)
+			}
+			if info.UserPermissions.IsAdmin != tt.isAdmin {
+				t.Errorf("Expected is_admin %v, but got %v", tt.isAdmin, info.UserPermissions.IsAdmin)
+			}
+		})
+	}
+}
+
+func TestGroupParticipantRows(t *testing.T) {
+	group := &GroupInfo{
+		JID:  "120363000000000000@g.us",
+		Name: "Equipe",
+		Participants: []GroupParticipant{
+			{JID: "5511987654321@s.whatsapp.net", IsAdmin: true, IsSuperAdmin: true},
+			{JID: "123456789012345@lid", LID: "123456789012345@lid", PhoneNumber: "5511912345678@s.whatsapp.net", IsAdmin: true},
+			{JID: "987654321098765@lid", LID: "987654321098765@lid", DisplayName: "Maria"},
+		},
+	}
+
+	expected := []GroupParticipantRow{
+		{GroupJID: group.JID, GroupName: "Equipe", JID: "5511987654321@s.whatsapp.net", PhoneNumber: "5511987654321", Role: ParticipantRoleSuperAdmin},
+		{GroupJID: group.JID, GroupName: "Equipe", JID: "123456789012345@lid", PhoneNumber: "5511912345678", LID: "123456789012345@lid", Role: ParticipantRoleAdmin},
+		{GroupJID: group.JID, GroupName: "Equipe", JID: "987654321098765@lid", LID: "987654321098765@lid", DisplayName: "Maria", Role: ParticipantRoleMember},
+	}
+
+	rows := GroupParticipantRows(group)
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("Expected rows %+v, but got %+v", expected, rows)
+	}
+}
+
+func TestAggregateCommunityMembers(t *testing.T) {
+	announcement := &GroupInfo{
+		JID:      "120363000000000001@g.us",
+		Name:     "Avisos",
+		Settings: GroupSettings{IsDefaultSubGroup: true},
+		Participants: []GroupParticipant{
+			{JID: "123456789012345@lid", LID: "123456789012345@lid", PhoneNumber: "5511912345678@s.whatsapp.net", IsSuperAdmin: true, IsAdmin: true},
+			{JID: "987654321098765@lid", LID: "987654321098765@lid"},
+		},
+	}
+	linked := &GroupInfo{
+		JID:  "120363000000000002@g.us",
+		Name: "Vendas",
+		Participants: []GroupParticipant{
+			{JID: "5511912345678@s.whatsapp.net"},
+			{JID: "5511987654321@s.whatsapp.net", LID: "987654321098765@lid", DisplayName: "Maria", IsAdmin: true},
+		},
+	}
+
+	members := AggregateCommunityMembers("120363000000000000@g.us", []*GroupInfo{announcement, linked})
+
+	expected := []CommunityMember{
+		{
+			JID:         "5511912345678@s.whatsapp.net",
+			PhoneNumber: "5511912345678@s.whatsapp.net",
+			LID:         "123456789012345@lid",
+			IsAdmin:     true,
+			Groups: []CommunityMemberGroup{
+				{JID: announcement.JID, Name: "Avisos", Role: ParticipantRoleSuperAdmin, IsAnnouncement: true},
+				{JID: linked.JID, Name: "Vendas", Role: ParticipantRoleMember},
+			},
+		},
+		{
+			JID:         "5511987654321@s.whatsapp.net",
+			PhoneNumber: "5511987654321@s.whatsapp.net",
+			LID:         "987654321098765@lid",
+			DisplayName: "Maria",
+			IsAdmin:     true,
+			Groups: []CommunityMemberGroup{
+				{JID: announcement.JID, Name: "Avisos", Role: ParticipantRoleMember, IsAnnouncement: true},
+				{JID: linked.JID, Name: "Vendas", Role: ParticipantRoleAdmin},
+			},
+		},
+	}
+
+	if members.GroupsCount != 2 || members.TotalMembers != 2 {
+		t.Errorf("Expected 2 groups and 2 members, but got %d groups and %d members", members.GroupsCount, members.TotalMembers)
+	}
+	if !reflect.DeepEqual(members.Members, expected) {
+		t.Errorf("Expected members %+v, but got %+v", expected, members.Members)
+	}
+}
+
+func TestToNewsletterPostStats(t *testing.T) {
+	sent := time.Unix(1700000000, 0)
+	messages := []*types.NewsletterMessage{
+		{
+			MessageServerID: 120,
+			MessageID:       "3EB0POST",
+			Type:            "media",
+			Timestamp:       sent,
+			ViewsCount:      340,
+			ReactionCounts:  map[string]int{"👍": 12, "❤️": 5},
+			Message:         &waE2E.Message{ImageMessage: &waE2E.ImageMessage{Caption: proto.String("Novidades")}},
+		},
+		{
+			MessageServerID: 121,
+			MessageID:       "3EB0UPDATE",
+			ViewsCount:      18,
+		},
+	}
+
+	expected := []NewsletterPostStats{
+		{
+			MessageID:      "3EB0POST",
+			ServerID:       120,
+			Type:           "media",
+			Timestamp:      sent,
+			ViewsCount:     340,
+			ReactionCounts: map[string]int{"👍": 12, "❤️": 5},
+			TotalReactions: 17,
+			Text:           "Novidades",
+			MediaType:      NewsletterPostImage,
+		},
+		{
+			MessageID:      "3EB0UPDATE",
+			ServerID:       121,
+			ViewsCount:     18,
+			ReactionCounts: map[string]int{},
+		},
+	}
+
+	posts := ToNewsletterPostStats(messages)
+	if !reflect.DeepEqual(posts, expected) {
+		t.Errorf("Expected posts %+v, but got %+v", expected, posts)
+	}
+}
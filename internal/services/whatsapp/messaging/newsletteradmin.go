@@ -0,0 +1,340 @@
+// internal/services/whatsapp/messaging/newsletteradmin.go
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/pkg/logger"
+)
+
+// Newsletter admin MEX query IDs.
+// queryNewsletterSubscribersID is whatsmeow's queryNewsletterSubscribers (newsletter.go). The others are not in
+// whatsmeow and were taken from WhatsApp Web requests, like mutationUpdateNewsletterQueryID; WhatsApp rotates these
+// IDs, so they are only sent through MEX and a rejected ID fails the operation instead of trying another protocol.
+const (
+	queryNewsletterAdminCountID     = "7130823597031706"
+	queryNewsletterSubscribersID    = "9800646650009898"
+	mutationNewsletterChangeOwnerID = "7341777602580933"
+	mutationNewsletterDemoteAdminID = "6551828931592903"
+	mutationNewsletterDeleteID      = "8316537688363079"
+)
+
+// Quantidade de seguidores consultados para encontrar os administradores do canal
+const newsletterSubscribersQueryCount = 500
+
+// Validade do convite para administrar o canal, a mesma usada pelos apps oficiais
+const newsletterAdminInviteTTL = 7 * 24 * time.Hour
+
+// NewsletterAdmin representa um administrador do canal
+type NewsletterAdmin struct {
+	JID  string `json:"jid"`
+	Role string `json:"role"`
+}
+
+// NewsletterAdmins representa a lista de administradores do canal.
+// AdminCount vem do servidor e pode ser maior que a lista quando algum administrador não é retornado na consulta de seguidores.
+type NewsletterAdmins struct {
+	NewsletterJID string            `json:"newsletter_jid"`
+	AdminCount    int               `json:"admin_count"`
+	Admins        []NewsletterAdmin `json:"admins"`
+}
+
+// NewsletterAdminInvite representa um convite enviado para administrar o canal
+type NewsletterAdminInvite struct {
+	NewsletterJID string    `json:"newsletter_jid"`
+	Invitee       string    `json:"invitee"`
+	MessageID     string    `json:"message_id"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// newsletterSubscriberEdge é um item da consulta de seguidores do canal.
+// O papel e o JID podem vir no próprio item ou dentro de node, dependendo da versão do servidor.
+type newsletterSubscriberEdge struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+	Node *struct {
+		ID   string `json:"id"`
+		Role string `json:"role"`
+	} `json:"node"`
+}
+
+// InviteNewsletterAdmin envia ao usuário um convite para administrar o canal.
+// O convite só tem efeito quando o convidado o aceita em um app oficial.
+func (s *NewsletterService) InviteNewsletterAdmin(userID, jid, user, caption string) (interface{}, error) {
+	client, parsedJID, info, err := s.newsletterRoleClient(userID, jid, false)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteeJID, err := s.resolveNewsletterAdminJID(userID, client, user, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	expiresAt := time.Now().Add(newsletterAdminInviteTTL)
+	invite := &waE2E.NewsletterAdminInviteMessage{
+		NewsletterJID:    proto.String(parsedJID.String()),
+		NewsletterName:   proto.String(info.ThreadMeta.Name.Text),
+		InviteExpiration: proto.Int64(expiresAt.Unix()),
+	}
+	if caption != "" {
+		invite.Caption = proto.String(caption)
+	}
+
+	resp, err := client.SendMessage(ctx, inviteeJID, &waE2E.Message{
+		NewsletterAdminInviteMessage: invite,
+	})
+	if err != nil {
+		logger.Error("Falha ao enviar convite de administrador do canal", "error", err, "user_id", userID, "jid", jid, "invitee", inviteeJID.String())
+		return nil, fmt.Errorf("falha ao enviar convite de administrador: %w", err)
+	}
+
+	logger.Debug("Convite de administrador do canal enviado",
+		"user_id", userID,
+		"jid", jid,
+		"invitee", inviteeJID.String(),
+		"message_id", resp.ID)
+
+	return &NewsletterAdminInvite{
+		NewsletterJID: parsedJID.String(),
+		Invitee:       inviteeJID.String(),
+		MessageID:     resp.ID,
+		ExpiresAt:     expiresAt,
+	}, nil
+}
+
+// ListNewsletterAdmins lista o dono e os administradores do canal
+func (s *NewsletterService) ListNewsletterAdmins(userID, jid string) (interface{}, error) {
+	client, parsedJID, _, err := s.newsletterRoleClient(userID, jid, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	result := &NewsletterAdmins{
+		NewsletterJID: parsedJID.String(),
+		Admins:        make([]NewsletterAdmin, 0),
+	}
+
+	countData, err := s.sendNewsletterMex(client, ctx, queryNewsletterAdminCountID, map[string]interface{}{
+		"newsletter_id": parsedJID.String(),
+	})
+	if err != nil {
+		logger.Warn("Falha ao obter quantidade de administradores do canal", "error", err, "user_id", userID, "jid", jid)
+	} else {
+		var countResp struct {
+			Admin struct {
+				AdminCount int `json:"admin_count"`
+			} `json:"xwa2_newsletter_admin"`
+		}
+		if err := json.Unmarshal(countData, &countResp); err == nil {
+			result.AdminCount = countResp.Admin.AdminCount
+		}
+	}
+
+	subscribersData, err := s.sendNewsletterMex(client, ctx, queryNewsletterSubscribersID, map[string]interface{}{
+		"input": map[string]interface{}{
+			"newsletter_id": parsedJID.String(),
+			"count":         newsletterSubscribersQueryCount,
+		},
+	})
+	if err != nil {
+		logger.Error("Falha ao listar administradores do canal", "error", err, "user_id", userID, "jid", jid)
+		return nil, fmt.Errorf("falha ao listar administradores do canal: %w", err)
+	}
+
+	var subscribersResp struct {
+		Subscribers struct {
+			Subscribers struct {
+				Edges []newsletterSubscriberEdge `json:"edges"`
+			} `json:"subscribers"`
+		} `json:"xwa2_newsletter_subscribers"`
+	}
+	if err := json.Unmarshal(subscribersData, &subscribersResp); err != nil {
+		return nil, fmt.Errorf("falha ao interpretar administradores do canal: %w", err)
+	}
+
+	for _, edge := range subscribersResp.Subscribers.Subscribers.Edges {
+		id, role := edge.ID, edge.Role
+		if edge.Node != nil {
+			if id == "" {
+				id = edge.Node.ID
+			}
+			if role == "" {
+				role = edge.Node.Role
+			}
+		}
+
+		role = strings.ToLower(role)
+		if id == "" || (role != string(types.NewsletterRoleOwner) && role != string(types.NewsletterRoleAdmin)) {
+			continue
+		}
+		result.Admins = append(result.Admins, NewsletterAdmin{JID: id, Role: role})
+	}
+
+	if result.AdminCount < len(result.Admins) {
+		result.AdminCount = len(result.Admins)
+	}
+
+	logger.Debug("Administradores do canal obtidos",
+		"user_id", userID,
+		"jid", jid,
+		"admin_count", result.AdminCount,
+		"admins_listed", len(result.Admins))
+
+	return result, nil
+}
+
+// RevokeNewsletterAdmin remove os direitos de administrador de um usuário do canal
+func (s *NewsletterService) RevokeNewsletterAdmin(userID, jid, user string) error {
+	client, parsedJID, _, err := s.newsletterRoleClient(userID, jid, true)
+	if err != nil {
+		return err
+	}
+
+	adminJID, err := s.resolveNewsletterAdminJID(userID, client, user, true)
+	if err != nil {
+		return err
+	}
+
+	return s.runNewsletterAdminMutation(userID, client, parsedJID.String(), adminJID.String(), "remover administrador do canal", mutationNewsletterDemoteAdminID)
+}
+
+// TransferNewsletterOwnership transfere a propriedade do canal para outro administrador.
+// Após a transferência a sessão passa a ser administradora do canal.
+func (s *NewsletterService) TransferNewsletterOwnership(userID, jid, user string) error {
+	client, parsedJID, _, err := s.newsletterRoleClient(userID, jid, true)
+	if err != nil {
+		return err
+	}
+
+	ownerJID, err := s.resolveNewsletterAdminJID(userID, client, user, true)
+	if err != nil {
+		return err
+	}
+
+	return s.runNewsletterAdminMutation(userID, client, parsedJID.String(), ownerJID.String(), "transferir propriedade do canal", mutationNewsletterChangeOwnerID)
+}
+
+// DeleteNewsletter apaga definitivamente o canal; apenas o dono pode fazer isso
+func (s *NewsletterService) DeleteNewsletter(userID, jid string) error {
+	client, parsedJID, _, err := s.newsletterRoleClient(userID, jid, true)
+	if err != nil {
+		return err
+	}
+
+	return s.runNewsletterAdminMutation(userID, client, parsedJID.String(), "", "apagar canal", mutationNewsletterDeleteID)
+}
+
+// runNewsletterAdminMutation executa uma operação de administração via MEX.
+// Operações como apagar o canal e transferir a propriedade são irreversíveis, por isso uma falha
+// é devolvida como está, sem tentar outro protocolo.
+func (s *NewsletterService) runNewsletterAdminMutation(userID string, client *whatsmeow.Client, newsletterJID, targetJID, operation, queryID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	variables := map[string]interface{}{
+		"newsletter_id": newsletterJID,
+	}
+	if targetJID != "" {
+		variables["user_id"] = targetJID
+	}
+
+	if _, err := s.sendNewsletterMex(client, ctx, queryID, variables); err != nil {
+		logger.Error("Falha na administração do canal",
+			"operation", operation,
+			"error", err,
+			"user_id", userID,
+			"newsletter_jid", newsletterJID,
+			"target_jid", targetJID)
+		return fmt.Errorf("falha ao %s: %w", operation, err)
+	}
+
+	logger.Debug("Operação de administração do canal concluída",
+		"operation", operation,
+		"user_id", userID,
+		"newsletter_jid", newsletterJID,
+		"target_jid", targetJID)
+
+	return nil
+}
+
+// sendNewsletterMex sends a newsletter MEX (GraphQL-like) query and returns its data
+func (s *NewsletterService) sendNewsletterMex(client *whatsmeow.Client, ctx context.Context, queryID string, variables map[string]interface{}) (json.RawMessage, error) {
+	result, err := client.DangerousInternals().SendMexIQ(ctx, queryID, variables)
+	if err != nil {
+		return nil, fmt.Errorf("MEX query failed: %w", err)
+	}
+
+	logger.Debug("MEX query succeeded",
+		"query_id", queryID,
+		"result", string(result))
+	return result, nil
+}
+
+// newsletterRoleClient retorna o cliente da sessão se ela administrar o canal.
+// Com requireOwner, apenas o dono do canal é aceito.
+func (s *NewsletterService) newsletterRoleClient(userID, jid string, requireOwner bool) (*whatsmeow.Client, types.JID, *types.NewsletterMetadata, error) {
+	client, parsedJID, err := s.newsletterClient(userID, jid)
+	if err != nil {
+		return nil, types.JID{}, nil, err
+	}
+
+	info, err := client.GetNewsletterInfo(parsedJID)
+	if err != nil {
+		return nil, types.JID{}, nil, fmt.Errorf("falha ao obter informações do canal: %w", err)
+	}
+
+	var role types.NewsletterRole
+	if info.ViewerMeta != nil {
+		role = info.ViewerMeta.Role
+	}
+
+	if requireOwner && role != types.NewsletterRoleOwner {
+		return nil, types.JID{}, nil, fmt.Errorf("apenas o dono pode realizar esta operação no canal: %s", jid)
+	}
+	if role != types.NewsletterRoleOwner && role != types.NewsletterRoleAdmin {
+		return nil, types.JID{}, nil, fmt.Errorf("sessão não administra o canal: %s", jid)
+	}
+
+	return client, parsedJID, info, nil
+}
+
+// resolveNewsletterAdminJID resolve o usuário informado por número ou JID.
+// Com preferLID, usa o LID conhecido do número, que é o identificador usado pelo servidor nos canais.
+func (s *NewsletterService) resolveNewsletterAdminJID(userID string, client *whatsmeow.Client, user string, preferLID bool) (types.JID, error) {
+	resolved, err := s.resolveParticipantJID(userID, client, user)
+	if err != nil {
+		return types.JID{}, err
+	}
+
+	jid, err := types.ParseJID(resolved)
+	if err != nil {
+		return types.JID{}, fmt.Errorf("JID inválido: %w", err)
+	}
+
+	if preferLID && jid.Server == types.DefaultUserServer && client.Store.LIDs != nil {
+		lid, err := client.Store.LIDs.GetLIDForPN(context.Background(), jid)
+		if err != nil {
+			logger.Debug("Falha ao obter LID do usuário", "jid", jid.String(), "error", err)
+		} else if !lid.IsEmpty() {
+			return lid, nil
+		}
+	}
+
+	return jid, nil
+}
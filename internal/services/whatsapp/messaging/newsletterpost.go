@@ -0,0 +1,233 @@
+// internal/services/whatsapp/messaging/newsletterpost.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// Tipos de publicação aceitos em canais
+const (
+	NewsletterPostText  = "text"
+	NewsletterPostImage = "image"
+	NewsletterPostVideo = "video"
+	NewsletterPostPoll  = "poll"
+)
+
+// NewsletterPostResult representa uma publicação enviada, editada ou apagada em um canal.
+// O MessageID identifica a publicação em edições e exclusões; o ServerID é o número atribuído pelo servidor do canal.
+type NewsletterPostResult struct {
+	NewsletterJID string    `json:"newsletter_jid"`
+	MessageID     string    `json:"message_id"`
+	ServerID      int       `json:"server_id,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// newsletterAdminClient retorna o cliente da sessão se ela for dona ou administradora do canal
+func (ms *MessageService) newsletterAdminClient(userID, newsletterJID string) (*whatsmeow.Client, types.JID, error) {
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists {
+		return nil, types.JID{}, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.Connected {
+		return nil, types.JID{}, fmt.Errorf("cliente não está conectado")
+	}
+
+	jid, err := types.ParseJID(newsletterJID)
+	if err != nil {
+		return nil, types.JID{}, fmt.Errorf("JID da newsletter inválido: %w", err)
+	}
+
+	if jid.Server != types.NewsletterServer {
+		return nil, types.JID{}, fmt.Errorf("JID não é uma newsletter: %s", newsletterJID)
+	}
+
+	info, err := client.WAClient.GetNewsletterInfo(jid)
+	if err != nil {
+		return nil, types.JID{}, fmt.Errorf("falha ao obter informações do canal: %w", err)
+	}
+
+	if info.ViewerMeta == nil || (info.ViewerMeta.Role != types.NewsletterRoleOwner && info.ViewerMeta.Role != types.NewsletterRoleAdmin) {
+		return nil, types.JID{}, fmt.Errorf("sessão não administra o canal: %s", newsletterJID)
+	}
+
+	client.LastActive = time.Now()
+
+	return client.WAClient, jid, nil
+}
+
+// PublishNewsletterPost publica texto, imagem, vídeo ou enquete em um canal administrado pela sessão
+func (ms *MessageService) PublishNewsletterPost(userID string, post worker.NewsletterPostPayload) (interface{}, error) {
+	waClient, jid, err := ms.newsletterAdminClient(userID, post.NewsletterJID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp whatsmeow.SendResponse
+	switch post.Type {
+	case NewsletterPostText:
+		if post.Text == "" {
+			return nil, fmt.Errorf("texto da publicação não pode ser vazio")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		resp, err = waClient.SendMessage(ctx, jid, &waE2E.Message{
+			Conversation: proto.String(post.Text),
+		})
+
+	case NewsletterPostImage, NewsletterPostVideo:
+		if post.MediaURL == "" {
+			return nil, fmt.Errorf("media_url é obrigatório para publicações de %s", post.Type)
+		}
+
+		resp, err = ms.sendMediaToNewsletter(userID, jid.String(), post.MediaURL, post.Type, post.Caption)
+
+	case NewsletterPostPoll:
+		pollMsg, pollErr := buildNewsletterPoll(post.PollName, post.PollOptions, post.SelectableCount)
+		if pollErr != nil {
+			return nil, pollErr
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		resp, err = waClient.SendMessage(ctx, jid, pollMsg)
+
+	default:
+		return nil, fmt.Errorf("tipo de publicação inválido: %s (deve ser 'text', 'image', 'video' ou 'poll')", post.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao publicar no canal: %w", err)
+	}
+
+	logger.Debug("Publicação enviada ao canal",
+		"user_id", userID,
+		"newsletter_jid", post.NewsletterJID,
+		"type", post.Type,
+		"message_id", resp.ID,
+		"server_id", resp.ServerID)
+
+	return &NewsletterPostResult{
+		NewsletterJID: jid.String(),
+		MessageID:     resp.ID,
+		ServerID:      int(resp.ServerID),
+		Type:          post.Type,
+		Timestamp:     resp.Timestamp,
+	}, nil
+}
+
+// EditNewsletterPost substitui o texto de uma publicação do canal
+func (ms *MessageService) EditNewsletterPost(userID, newsletterJID, messageID, text string) (interface{}, error) {
+	if messageID == "" {
+		return nil, fmt.Errorf("ID da publicação não pode ser vazio")
+	}
+	if text == "" {
+		return nil, fmt.Errorf("texto da publicação não pode ser vazio")
+	}
+
+	waClient, jid, err := ms.newsletterAdminClient(userID, newsletterJID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	edit := waClient.BuildEdit(jid, messageID, &waE2E.Message{
+		Conversation: proto.String(text),
+	})
+
+	resp, err := waClient.SendMessage(ctx, jid, edit)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao editar publicação do canal: %w", err)
+	}
+
+	logger.Debug("Publicação do canal editada",
+		"user_id", userID,
+		"newsletter_jid", newsletterJID,
+		"message_id", messageID)
+
+	return &NewsletterPostResult{
+		NewsletterJID: jid.String(),
+		MessageID:     messageID,
+		ServerID:      int(resp.ServerID),
+		Timestamp:     resp.Timestamp,
+	}, nil
+}
+
+// DeleteNewsletterPost apaga uma publicação do canal
+func (ms *MessageService) DeleteNewsletterPost(userID, newsletterJID, messageID string) error {
+	if messageID == "" {
+		return fmt.Errorf("ID da publicação não pode ser vazio")
+	}
+
+	waClient, jid, err := ms.newsletterAdminClient(userID, newsletterJID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// A publicação é da própria sessão, por isso o remetente fica vazio
+	if _, err := waClient.SendMessage(ctx, jid, waClient.BuildRevoke(jid, types.EmptyJID, messageID)); err != nil {
+		return fmt.Errorf("falha ao apagar publicação do canal: %w", err)
+	}
+
+	logger.Debug("Publicação do canal apagada",
+		"user_id", userID,
+		"newsletter_jid", newsletterJID,
+		"message_id", messageID)
+
+	return nil
+}
+
+// buildNewsletterPoll monta a enquete de um canal
+func buildNewsletterPoll(name string, options []string, selectableCount int) (*waE2E.Message, error) {
+	if name == "" {
+		return nil, fmt.Errorf("nome da enquete não pode ser vazio")
+	}
+
+	if len(options) < 2 {
+		return nil, fmt.Errorf("poll must have at least 2 options")
+	}
+
+	if len(options) > 12 {
+		return nil, fmt.Errorf("poll cannot have more than 12 options")
+	}
+
+	if selectableCount < 1 {
+		selectableCount = 1
+	}
+	if selectableCount > len(options) {
+		selectableCount = len(options)
+	}
+
+	pollOptions := make([]*waE2E.PollCreationMessage_Option, len(options))
+	for i, option := range options {
+		pollOptions[i] = &waE2E.PollCreationMessage_Option{
+			OptionName: proto.String(option),
+		}
+	}
+
+	return &waE2E.Message{
+		PollCreationMessage: &waE2E.PollCreationMessage{
+			Name:                   proto.String(name),
+			Options:                pollOptions,
+			SelectableOptionsCount: proto.Uint32(uint32(selectableCount)),
+		},
+	}, nil
+}
@@ -0,0 +1,215 @@
+// internal/services/whatsapp/messaging/newsletterstats.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/pkg/logger"
+)
+
+// Limite de publicações por consulta ao histórico do canal
+const (
+	defaultNewsletterPostsCount = 50
+	maxNewsletterPostsCount     = 100
+)
+
+// NewsletterPostStats representa uma publicação do canal com seus números de engajamento.
+// O conteúdo só vem no histórico; nas atualizações apenas os contadores são enviados.
+type NewsletterPostStats struct {
+	MessageID      string         `json:"message_id"`
+	ServerID       int            `json:"server_id"`
+	Type           string         `json:"type,omitempty"`
+	Timestamp      time.Time      `json:"timestamp"`
+	ViewsCount     int            `json:"views_count"`
+	ReactionCounts map[string]int `json:"reaction_counts"`
+	TotalReactions int            `json:"total_reactions"`
+	Text           string         `json:"text,omitempty"`
+	MediaType      string         `json:"media_type,omitempty"`
+}
+
+// NewsletterPostsPage representa uma página de publicações do canal.
+// NextBefore é o ServerID a ser usado na próxima página, ou zero quando não há mais publicações.
+type NewsletterPostsPage struct {
+	NewsletterJID string                `json:"newsletter_jid"`
+	Posts         []NewsletterPostStats `json:"posts"`
+	NextBefore    int                   `json:"next_before,omitempty"`
+}
+
+// NewsletterLiveUpdatesSubscription representa uma inscrição em atualizações ao vivo do canal
+type NewsletterLiveUpdatesSubscription struct {
+	NewsletterJID string    `json:"newsletter_jid"`
+	Duration      int       `json:"duration_seconds"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// GetNewsletterPosts lista as publicações do canal, das mais recentes para as mais antigas, com visualizações e reações
+func (s *NewsletterService) GetNewsletterPosts(userID, jid string, count, before int) (interface{}, error) {
+	client, parsedJID, err := s.newsletterClient(userID, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	count = clampNewsletterPostsCount(count)
+	messages, err := client.GetNewsletterMessages(parsedJID, &whatsmeow.GetNewsletterMessagesParams{
+		Count:  count,
+		Before: types.MessageServerID(before),
+	})
+	if err != nil {
+		logger.Error("Falha ao obter publicações do canal", "error", err, "user_id", userID, "jid", jid)
+		return nil, fmt.Errorf("falha ao obter publicações do canal: %w", err)
+	}
+
+	page := &NewsletterPostsPage{
+		NewsletterJID: parsedJID.String(),
+		Posts:         ToNewsletterPostStats(messages),
+	}
+
+	// Uma página cheia indica que pode haver publicações mais antigas
+	if len(messages) == count {
+		oldest := messages[0].MessageServerID
+		for _, msg := range messages {
+			if msg.MessageServerID < oldest {
+				oldest = msg.MessageServerID
+			}
+		}
+		page.NextBefore = int(oldest)
+	}
+
+	logger.Debug("Publicações do canal obtidas",
+		"user_id", userID,
+		"jid", jid,
+		"posts_count", len(page.Posts))
+
+	return page, nil
+}
+
+// GetNewsletterPostUpdates retorna os contadores atualizados das publicações do canal.
+// since e after limitam as atualizações às mais recentes que o horário ou o ServerID informados.
+func (s *NewsletterService) GetNewsletterPostUpdates(userID, jid string, count int, since time.Time, after int) (interface{}, error) {
+	client, parsedJID, err := s.newsletterClient(userID, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := client.GetNewsletterMessageUpdates(parsedJID, &whatsmeow.GetNewsletterUpdatesParams{
+		Count: clampNewsletterPostsCount(count),
+		Since: since,
+		After: types.MessageServerID(after),
+	})
+	if err != nil {
+		logger.Error("Falha ao obter atualizações do canal", "error", err, "user_id", userID, "jid", jid)
+		return nil, fmt.Errorf("falha ao obter atualizações do canal: %w", err)
+	}
+
+	return &NewsletterPostsPage{
+		NewsletterJID: parsedJID.String(),
+		Posts:         ToNewsletterPostStats(updates),
+	}, nil
+}
+
+// SubscribeNewsletterLiveUpdates inscreve a sessão nas atualizações ao vivo de visualizações e reações do canal.
+// A inscrição expira após a duração definida pelo servidor e deve ser renovada para continuar recebendo eventos.
+func (s *NewsletterService) SubscribeNewsletterLiveUpdates(userID, jid string) (interface{}, error) {
+	client, parsedJID, err := s.newsletterClient(userID, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	duration, err := client.NewsletterSubscribeLiveUpdates(ctx, parsedJID)
+	if err != nil {
+		logger.Error("Falha ao inscrever nas atualizações do canal", "error", err, "user_id", userID, "jid", jid)
+		return nil, fmt.Errorf("falha ao inscrever nas atualizações do canal: %w", err)
+	}
+
+	logger.Debug("Inscrição nas atualizações do canal realizada",
+		"user_id", userID,
+		"jid", jid,
+		"duration", duration)
+
+	return &NewsletterLiveUpdatesSubscription{
+		NewsletterJID: parsedJID.String(),
+		Duration:      int(duration.Seconds()),
+		ExpiresAt:     time.Now().Add(duration),
+	}, nil
+}
+
+// ToNewsletterPostStats converte as mensagens do canal para a estrutura de engajamento
+func ToNewsletterPostStats(messages []*types.NewsletterMessage) []NewsletterPostStats {
+	posts := make([]NewsletterPostStats, 0, len(messages))
+	for _, msg := range messages {
+		post := NewsletterPostStats{
+			MessageID:      msg.MessageID,
+			ServerID:       int(msg.MessageServerID),
+			Type:           msg.Type,
+			Timestamp:      msg.Timestamp,
+			ViewsCount:     msg.ViewsCount,
+			ReactionCounts: msg.ReactionCounts,
+		}
+		if post.ReactionCounts == nil {
+			post.ReactionCounts = map[string]int{}
+		}
+		for _, count := range post.ReactionCounts {
+			post.TotalReactions += count
+		}
+
+		if content := msg.Message; content != nil {
+			switch {
+			case content.GetConversation() != "":
+				post.Text = content.GetConversation()
+			case content.GetExtendedTextMessage() != nil:
+				post.Text = content.GetExtendedTextMessage().GetText()
+			case content.GetImageMessage() != nil:
+				post.MediaType = NewsletterPostImage
+				post.Text = content.GetImageMessage().GetCaption()
+			case content.GetVideoMessage() != nil:
+				post.MediaType = NewsletterPostVideo
+				post.Text = content.GetVideoMessage().GetCaption()
+			case content.GetAudioMessage() != nil:
+				post.MediaType = "audio"
+			case content.GetPollCreationMessage() != nil:
+				post.Text = content.GetPollCreationMessage().GetName()
+			}
+		}
+
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// newsletterClient retorna o cliente da sessão e o JID do canal validado
+func (s *NewsletterService) newsletterClient(userID, jid string) (*whatsmeow.Client, types.JID, error) {
+	client, err := s.getClient(userID)
+	if err != nil {
+		return nil, types.JID{}, err
+	}
+
+	parsedJID, err := types.ParseJID(jid)
+	if err != nil {
+		return nil, types.JID{}, fmt.Errorf("JID inválido: %w", err)
+	}
+
+	if parsedJID.Server != types.NewsletterServer {
+		return nil, types.JID{}, fmt.Errorf("JID não é uma newsletter: %s", jid)
+	}
+
+	return client, parsedJID, nil
+}
+
+// clampNewsletterPostsCount aplica o padrão e o limite de publicações por consulta
+func clampNewsletterPostsCount(count int) int {
+	if count <= 0 {
+		return defaultNewsletterPostsCount
+	}
+	if count > maxNewsletterPostsCount {
+		return maxNewsletterPostsCount
+	}
+	return count
+}
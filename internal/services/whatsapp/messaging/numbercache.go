@@ -0,0 +1,290 @@
+// internal/services/whatsapp/messaging/numbercache.go
+package messaging
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// Validade padrão das consultas IsOnWhatsApp em cache.
+// Números sem WhatsApp expiram antes, pois o contato pode criar a conta a qualquer momento.
+const (
+	DefaultNumberCachePositiveTTL = 24 * time.Hour
+	DefaultNumberCacheNegativeTTL = time.Hour
+)
+
+const (
+	// MaxNumberCheckBatch é o máximo de números aceitos em uma verificação em lote
+	MaxNumberCheckBatch = 1000
+
+	// numberLookupBatchSize limita quantos números são enviados em cada chamada IsOnWhatsApp
+	numberLookupBatchSize = 250
+)
+
+// NumberLookupCache guarda os resultados de IsOnWhatsApp para evitar consultas repetidas
+type NumberLookupCache interface {
+	GetNumberLookups(userID string, phones []string) (map[string]storage.NumberLookup, error)
+	SaveNumberLookups(userID string, lookups []storage.NumberLookup) error
+	DeleteNumberLookups(userID string, phones ...string) error
+}
+
+// NumberCheckResult é o resultado da verificação de um número no WhatsApp
+type NumberCheckResult struct {
+	Input  string `json:"input"`
+	Number string `json:"number,omitempty"`
+	Exists bool   `json:"exists"`
+	JID    string `json:"jid,omitempty"`
+	Cached bool   `json:"cached"`
+	Error  string `json:"error,omitempty"`
+}
+
+// numberLookup é a resolução de um número a partir do cache ou do WhatsApp
+type numberLookup struct {
+	jid    string
+	exists bool
+	cached bool
+}
+
+// SetNumberCache define o cache de consultas IsOnWhatsApp e a validade dos resultados.
+// Validades zeradas usam os valores padrão.
+func (p *phoneResolver) SetNumberCache(cache NumberLookupCache, positiveTTL, negativeTTL time.Duration) {
+	if positiveTTL <= 0 {
+		positiveTTL = DefaultNumberCachePositiveTTL
+	}
+	if negativeTTL <= 0 {
+		negativeTTL = DefaultNumberCacheNegativeTTL
+	}
+
+	p.numberCache = cache
+	p.positiveTTL = positiveTTL
+	p.negativeTTL = negativeTTL
+}
+
+// lookupPhoneJID retorna o JID da primeira variante do número registrada no WhatsApp
+func (p *phoneResolver) lookupPhoneJID(userID string, waClient *whatsmeow.Client, number phone.Number) (string, bool, error) {
+	results, err := p.lookupNumbers(userID, waClient, []phone.Number{number})
+	if err != nil {
+		return "", false, err
+	}
+	return results[0].jid, results[0].exists, nil
+}
+
+// lookupNumbers resolve vários números, consultando o cache antes do WhatsApp.
+// Os números ausentes do cache são verificados em lotes, e os resultados voltam na mesma ordem da entrada.
+func (p *phoneResolver) lookupNumbers(userID string, waClient *whatsmeow.Client, numbers []phone.Number) ([]numberLookup, error) {
+	// Candidatos únicos de todos os números (ex.: com e sem o nono dígito)
+	var candidates []string
+	seen := make(map[string]bool)
+	for _, number := range numbers {
+		for _, candidate := range number.Candidates() {
+			if !seen[candidate] {
+				seen[candidate] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	known := p.cachedLookups(userID, candidates)
+
+	results := make([]numberLookup, len(numbers))
+	var pending []int
+	var missing []string
+	missingSeen := make(map[string]bool)
+	for i, number := range numbers {
+		if result, ok := resolveLookup(number, known); ok {
+			result.cached = true
+			results[i] = result
+			continue
+		}
+
+		pending = append(pending, i)
+		for _, candidate := range number.Candidates() {
+			if _, ok := known[candidate]; !ok && !missingSeen[candidate] {
+				missingSeen[candidate] = true
+				missing = append(missing, candidate)
+			}
+		}
+	}
+
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	if waClient == nil {
+		return nil, fmt.Errorf("cliente não está conectado")
+	}
+
+	lookups, err := queryNumbers(waClient, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.numberCache != nil {
+		if err := p.numberCache.SaveNumberLookups(userID, lookups); err != nil {
+			logger.Warn("Falha ao salvar consultas de números em cache", "user_id", userID, "error", err)
+		}
+	}
+
+	for _, lookup := range lookups {
+		known[lookup.Phone] = lookup
+	}
+	for _, i := range pending {
+		results[i], _ = resolveLookup(numbers[i], known)
+	}
+
+	return results, nil
+}
+
+// cachedLookups retorna as consultas em cache ainda válidas
+func (p *phoneResolver) cachedLookups(userID string, phones []string) map[string]storage.NumberLookup {
+	fresh := make(map[string]storage.NumberLookup, len(phones))
+	if p.numberCache == nil || len(phones) == 0 {
+		return fresh
+	}
+
+	lookups, err := p.numberCache.GetNumberLookups(userID, phones)
+	if err != nil {
+		// Sem cache a consulta segue direto para o WhatsApp
+		logger.Warn("Falha ao consultar cache de números", "user_id", userID, "error", err)
+		return fresh
+	}
+
+	now := time.Now()
+	for digits, lookup := range lookups {
+		ttl := p.negativeTTL
+		if lookup.Exists {
+			ttl = p.positiveTTL
+		}
+		if now.Sub(lookup.CheckedAt) < ttl {
+			fresh[digits] = lookup
+		}
+	}
+
+	return fresh
+}
+
+// invalidateNumberLookup remove do cache o destinatário de um envio que falhou,
+// para que o próximo envio consulte o WhatsApp novamente
+func (p *phoneResolver) invalidateNumberLookup(userID, user string) {
+	if p.numberCache == nil {
+		return
+	}
+
+	phones := []string{user}
+	if number, err := phone.Parse("+"+user, ""); err == nil {
+		phones = number.Candidates()
+	}
+
+	if err := p.numberCache.DeleteNumberLookups(userID, phones...); err != nil {
+		logger.Warn("Falha ao invalidar cache de números", "user_id", userID, "phone", user, "error", err)
+	}
+}
+
+// resolveLookup escolhe o JID pela ordem de preferência das variantes.
+// Retorna false se alguma variante preferida ainda não foi consultada.
+func resolveLookup(number phone.Number, known map[string]storage.NumberLookup) (numberLookup, bool) {
+	for _, candidate := range number.Candidates() {
+		lookup, ok := known[candidate]
+		if !ok {
+			return numberLookup{}, false
+		}
+		if lookup.Exists {
+			return numberLookup{jid: lookup.JID, exists: true}, true
+		}
+	}
+	return numberLookup{}, true
+}
+
+// queryNumbers consulta o WhatsApp em lotes e retorna um resultado por número, inclusive os não registrados
+func queryNumbers(waClient *whatsmeow.Client, phones []string) ([]storage.NumberLookup, error) {
+	lookups := make([]storage.NumberLookup, 0, len(phones))
+
+	for start := 0; start < len(phones); start += numberLookupBatchSize {
+		end := start + numberLookupBatchSize
+		if end > len(phones) {
+			end = len(phones)
+		}
+		batch := phones[start:end]
+
+		queries := make([]string, len(batch))
+		for i, digits := range batch {
+			queries[i] = "+" + digits
+		}
+
+		responses, err := waClient.IsOnWhatsApp(queries)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao verificar números: %w", err)
+		}
+
+		registered := make(map[string]string, len(responses))
+		for _, response := range responses {
+			logger.Debug("IsOnWhatsApp response", "query", response.Query, "jid", response.JID.String(), "is_in", response.IsIn)
+			if response.IsIn {
+				registered[strings.TrimPrefix(response.Query, "+")] = response.JID.String()
+			}
+		}
+
+		checkedAt := time.Now()
+		for _, digits := range batch {
+			jid, exists := registered[digits]
+			lookups = append(lookups, storage.NumberLookup{
+				Phone:     digits,
+				JID:       jid,
+				Exists:    exists,
+				CheckedAt: checkedAt,
+			})
+		}
+	}
+
+	return lookups, nil
+}
+
+// CheckNumbersOnWhatsApp verifica vários números de uma vez, usando o cache quando possível.
+// Números inválidos são reportados individualmente sem interromper o lote.
+func (ms *MessageService) CheckNumbersOnWhatsApp(userID string, numbers []string) ([]NumberCheckResult, error) {
+	if len(numbers) > MaxNumberCheckBatch {
+		return nil, fmt.Errorf("máximo de %d números por verificação, recebido %d", MaxNumberCheckBatch, len(numbers))
+	}
+
+	results := make([]NumberCheckResult, len(numbers))
+	parsed := make([]phone.Number, 0, len(numbers))
+	indexes := make([]int, 0, len(numbers))
+	for i, input := range numbers {
+		results[i].Input = input
+
+		number, err := ms.parsePhone(userID, input)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		results[i].Number = number.E164()
+		parsed = append(parsed, number)
+		indexes = append(indexes, i)
+	}
+
+	if len(parsed) == 0 {
+		return results, nil
+	}
+
+	lookups, err := ms.lookupNumbers(userID, ms.connectedWAClient(userID), parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, lookup := range lookups {
+		result := &results[indexes[i]]
+		result.Exists = lookup.exists
+		result.JID = lookup.jid
+		result.Cached = lookup.cached
+	}
+
+	return results, nil
+}
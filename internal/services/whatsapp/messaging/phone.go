@@ -0,0 +1,87 @@
+// internal/services/whatsapp/messaging/phone.go
+package messaging
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/pkg/logger"
+)
+
+// RegionResolver retorna a região padrão (ISO 3166, ex.: "BR") usada nos números sem código do país
+type RegionResolver func(userID string) string
+
+// phoneResolver normaliza números de telefone e resolve o JID registrado no WhatsApp.
+// É compartilhado pelos serviços que aceitam números como destinatário ou participante.
+type phoneResolver struct {
+	regionResolver RegionResolver
+	numberCache    NumberLookupCache
+	positiveTTL    time.Duration
+	negativeTTL    time.Duration
+}
+
+// SetRegionResolver define como obter a região padrão de cada sessão
+func (p *phoneResolver) SetRegionResolver(resolver RegionResolver) {
+	p.regionResolver = resolver
+}
+
+// regionFor retorna a região padrão da sessão
+func (p *phoneResolver) regionFor(userID string) string {
+	if p.regionResolver != nil {
+		if region := p.regionResolver(userID); region != "" {
+			return region
+		}
+	}
+	return phone.DefaultRegion
+}
+
+// parsePhone normaliza um número usando a região padrão da sessão
+func (p *phoneResolver) parsePhone(userID, input string) (phone.Number, error) {
+	return phone.Parse(input, p.regionFor(userID))
+}
+
+// resolveParticipantJID aceita um JID ou número de telefone e retorna o JID do participante
+func (p *phoneResolver) resolveParticipantJID(userID string, waClient *whatsmeow.Client, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", fmt.Errorf("número do participante não pode estar vazio")
+	}
+
+	if strings.Contains(input, "@") {
+		if _, err := types.ParseJID(input); err != nil {
+			return "", fmt.Errorf("JID inválido: %w", err)
+		}
+		return input, nil
+	}
+
+	return p.resolvePhoneJID(userID, waClient, input)
+}
+
+// resolvePhoneJID normaliza o número e consulta o WhatsApp para obter o JID registrado,
+// testando as variantes do país (ex.: com e sem o nono dígito no Brasil).
+// Sem cliente conectado, ou se nenhuma variante estiver no WhatsApp, retorna o JID do número normalizado.
+func (p *phoneResolver) resolvePhoneJID(userID string, waClient *whatsmeow.Client, input string) (string, error) {
+	number, err := p.parsePhone(userID, input)
+	if err != nil {
+		return "", err
+	}
+
+	if waClient != nil {
+		jid, exists, err := p.lookupPhoneJID(userID, waClient, number)
+		if err != nil {
+			// Continuar mesmo se a verificação falhar
+			logger.Debug("Erro ao verificar número no WhatsApp", "number", number.E164(), "error", err)
+		} else if exists {
+			return jid, nil
+		}
+	}
+
+	fallbackJID := number.JID()
+	logger.Debug("Usando JID de fallback", "number", number.E164(), "jid", fallbackJID)
+	return fallbackJID, nil
+}
@@ -0,0 +1,129 @@
+// internal/services/whatsapp/messaging/privacy.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// PrivacySettings representa as configurações de privacidade da conta
+type PrivacySettings struct {
+	LastSeen     string `json:"last_seen"`
+	Online       string `json:"online"`
+	ProfilePhoto string `json:"profile_photo"`
+	About        string `json:"about"`
+	GroupAdd     string `json:"group_add"`
+	ReadReceipts string `json:"read_receipts"`
+	CallAdd      string `json:"call_add"`
+}
+
+// privacySettingChange é uma configuração a ser aplicada e os valores aceitos pelo WhatsApp
+type privacySettingChange struct {
+	field   string
+	name    types.PrivacySettingType
+	value   string
+	current types.PrivacySetting
+	allowed []types.PrivacySetting
+}
+
+// GetPrivacySettings consulta as configurações de privacidade atuais da conta no WhatsApp
+func (ms *MessageService) GetPrivacySettings(userID string) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	settings, err := waClient.TryFetchPrivacySettings(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter configurações de privacidade: %w", err)
+	}
+
+	return ToPrivacySettings(*settings), nil
+}
+
+// UpdatePrivacySettings aplica as configurações informadas, mantendo as demais como estão.
+// Configurações que já têm o valor pedido não são reenviadas, então o mesmo pedido pode ser repetido em várias contas.
+func (ms *MessageService) UpdatePrivacySettings(userID string, update worker.PrivacySettingsPayload) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	current, err := waClient.TryFetchPrivacySettings(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter configurações de privacidade: %w", err)
+	}
+
+	visibility := []types.PrivacySetting{types.PrivacySettingAll, types.PrivacySettingContacts, types.PrivacySettingContactBlacklist, types.PrivacySettingNone}
+	changes := []privacySettingChange{
+		{"last_seen", types.PrivacySettingTypeLastSeen, update.LastSeen, current.LastSeen, visibility},
+		{"online", types.PrivacySettingTypeOnline, update.Online, current.Online, []types.PrivacySetting{types.PrivacySettingAll, types.PrivacySettingMatchLastSeen}},
+		{"profile_photo", types.PrivacySettingTypeProfile, update.ProfilePhoto, current.Profile, visibility},
+		{"about", types.PrivacySettingTypeStatus, update.About, current.Status, visibility},
+		{"group_add", types.PrivacySettingTypeGroupAdd, update.GroupAdd, current.GroupAdd, visibility},
+		{"read_receipts", types.PrivacySettingTypeReadReceipts, update.ReadReceipts, current.ReadReceipts, []types.PrivacySetting{types.PrivacySettingAll, types.PrivacySettingNone}},
+		{"call_add", types.PrivacySettingTypeCallAdd, update.CallAdd, current.CallAdd, []types.PrivacySetting{types.PrivacySettingAll, types.PrivacySettingKnown}},
+	}
+
+	// Validar tudo antes de alterar qualquer configuração
+	for _, change := range changes {
+		if change.value != "" && !isAllowedPrivacyValue(types.PrivacySetting(change.value), change.allowed) {
+			return nil, fmt.Errorf("valor inválido para %s: %s (valores aceitos: %v)", change.field, change.value, change.allowed)
+		}
+	}
+
+	settings := *current
+	var applied []string
+	for _, change := range changes {
+		if change.value == "" || types.PrivacySetting(change.value) == change.current {
+			continue
+		}
+
+		settings, err = waClient.SetPrivacySetting(ctx, change.name, types.PrivacySetting(change.value))
+		if err != nil {
+			return nil, fmt.Errorf("falha ao alterar %s (já alteradas: %v): %w", change.field, applied, err)
+		}
+		applied = append(applied, change.field)
+	}
+
+	logger.Debug("Configurações de privacidade atualizadas",
+		"user_id", userID,
+		"changed", applied)
+
+	return ToPrivacySettings(settings), nil
+}
+
+// ToPrivacySettings converte as configurações de privacidade do whatsmeow para a estrutura da API
+func ToPrivacySettings(settings types.PrivacySettings) *PrivacySettings {
+	return &PrivacySettings{
+		LastSeen:     string(settings.LastSeen),
+		Online:       string(settings.Online),
+		ProfilePhoto: string(settings.Profile),
+		About:        string(settings.Status),
+		GroupAdd:     string(settings.GroupAdd),
+		ReadReceipts: string(settings.ReadReceipts),
+		CallAdd:      string(settings.CallAdd),
+	}
+}
+
+// isAllowedPrivacyValue verifica se o valor é aceito pela configuração
+func isAllowedPrivacyValue(value types.PrivacySetting, allowed []types.PrivacySetting) bool {
+	for _, candidate := range allowed {
+		if value == candidate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,295 @@
+// internal/services/whatsapp/messaging/profile.go
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types"
+
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// DefaultProfileCacheTTL é a validade padrão dos perfis de contatos em cache.
+// As URLs das fotos expiram no servidor, por isso o perfil é consultado novamente depois desse prazo.
+const DefaultProfileCacheTTL = 6 * time.Hour
+
+// ContactProfileCache guarda os perfis de contatos para evitar consultas repetidas
+type ContactProfileCache interface {
+	GetContactProfile(userID, jid string) (*storage.ContactProfile, error)
+	SaveContactProfile(userID string, profile storage.ContactProfile) error
+	DeleteContactProfile(userID, jid string) error
+}
+
+// OwnProfile representa o perfil da própria conta da sessão
+type OwnProfile struct {
+	JID        string `json:"jid"`
+	PushName   string `json:"push_name"`
+	About      string `json:"about"`
+	PictureURL string `json:"picture_url,omitempty"`
+	PictureID  string `json:"picture_id,omitempty"`
+}
+
+// ContactProfile representa o perfil público de um contato
+type ContactProfile struct {
+	storage.ContactProfile
+	Cached bool `json:"cached"`
+}
+
+// SetProfileCache define o cache de perfis de contatos e a validade das consultas.
+// Validade zerada usa o valor padrão.
+func (ms *MessageService) SetProfileCache(cache ContactProfileCache, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultProfileCacheTTL
+	}
+
+	ms.profileCache = cache
+	ms.profileCacheTTL = ttl
+}
+
+// GetOwnProfile retorna o nome, o recado e a foto da própria conta
+func (ms *MessageService) GetOwnProfile(userID string) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	selfJID := waClient.Store.ID.ToNonAD()
+	profile := &OwnProfile{
+		JID:      selfJID.String(),
+		PushName: waClient.Store.PushName,
+	}
+
+	info, err := waClient.GetUserInfo([]types.JID{selfJID})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter perfil da conta: %w", err)
+	}
+	if userInfo, ok := info[selfJID]; ok {
+		profile.About = userInfo.Status
+	}
+
+	picture, err := getProfilePicture(waClient, selfJID)
+	if err != nil {
+		return nil, err
+	}
+	if picture != nil {
+		profile.PictureURL = picture.URL
+		profile.PictureID = picture.ID
+	}
+
+	return profile, nil
+}
+
+// SetProfilePicture baixa a imagem da URL e a define como foto de perfil da conta
+func (ms *MessageService) SetProfilePicture(userID, imageURL string) (string, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Get(imageURL)
+	if err != nil {
+		return "", fmt.Errorf("falha ao baixar imagem da URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("falha ao baixar imagem: HTTP %d - %s", resp.StatusCode, resp.Status)
+	}
+
+	imageData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("falha ao ler dados da imagem: %w", err)
+	}
+
+	if len(imageData) == 0 {
+		return "", fmt.Errorf("dados da imagem estão vazios")
+	}
+
+	// O WhatsApp só aceita fotos de perfil em JPEG
+	jpegData, err := convertToJPEG(imageData, 85)
+	if err != nil {
+		return "", fmt.Errorf("falha ao converter imagem para JPEG: %w", err)
+	}
+
+	// A foto de perfil usa o mesmo namespace da foto de grupo, com o JID da própria conta
+	selfJID := waClient.Store.ID.ToNonAD()
+	pictureID, err := waClient.SetGroupPhoto(selfJID, jpegData)
+	if err != nil {
+		return "", fmt.Errorf("falha ao atualizar foto de perfil: %w", err)
+	}
+
+	ms.InvalidateContactProfile(userID, selfJID.String())
+
+	logger.Debug("Foto de perfil atualizada",
+		"user_id", userID,
+		"picture_id", pictureID,
+		"size_bytes", len(jpegData))
+
+	return pictureID, nil
+}
+
+// SetPushName altera o nome exibido da conta para os contatos
+func (ms *MessageService) SetPushName(userID, name string) error {
+	if name == "" {
+		return fmt.Errorf("nome não pode ser vazio")
+	}
+
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := waClient.SendAppState(ctx, appstate.BuildSettingPushName(name)); err != nil {
+		return fmt.Errorf("falha ao alterar nome da conta: %w", err)
+	}
+
+	// O nome também é enviado junto com a presença, por isso é salvo no dispositivo
+	waClient.Store.PushName = name
+	if err := waClient.Store.Save(ctx); err != nil {
+		logger.Warn("Falha ao salvar nome da conta no dispositivo", "user_id", userID, "error", err)
+	}
+
+	logger.Debug("Nome da conta alterado", "user_id", userID, "push_name", name)
+
+	return nil
+}
+
+// SetAbout altera o recado (about) da conta
+func (ms *MessageService) SetAbout(userID, about string) error {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := waClient.SetStatusMessage(about); err != nil {
+		return fmt.Errorf("falha ao alterar recado da conta: %w", err)
+	}
+
+	ms.InvalidateContactProfile(userID, waClient.Store.ID.ToNonAD().String())
+
+	logger.Debug("Recado da conta alterado", "user_id", userID)
+
+	return nil
+}
+
+// GetContactProfile retorna a foto, o recado e o nome comercial verificado de um contato.
+// O perfil é servido do cache enquanto válido; fresh ignora o cache e consulta o WhatsApp.
+func (ms *MessageService) GetContactProfile(userID, contact string, fresh bool) (interface{}, error) {
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := ms.resolveParticipantJID(userID, waClient, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := types.ParseJID(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("JID inválido: %w", err)
+	}
+	jid = jid.ToNonAD()
+
+	if !fresh && ms.profileCache != nil {
+		cached, err := ms.profileCache.GetContactProfile(userID, jid.String())
+		if err != nil {
+			logger.Warn("Falha ao consultar cache de perfis", "user_id", userID, "jid", jid.String(), "error", err)
+		} else if cached != nil && time.Since(cached.FetchedAt) < ms.profileCacheTTL {
+			return &ContactProfile{ContactProfile: *cached, Cached: true}, nil
+		}
+	}
+
+	info, err := waClient.GetUserInfo([]types.JID{jid})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter perfil do contato: %w", err)
+	}
+
+	profile := storage.ContactProfile{
+		JID:       jid.String(),
+		FetchedAt: time.Now(),
+	}
+	if userInfo, ok := info[jid]; ok {
+		profile.About = userInfo.Status
+		profile.PictureID = userInfo.PictureID
+		if userInfo.VerifiedName != nil && userInfo.VerifiedName.Details != nil {
+			profile.BusinessName = userInfo.VerifiedName.Details.GetVerifiedName()
+		}
+	}
+
+	picture, err := getProfilePicture(waClient, jid)
+	if err != nil {
+		return nil, err
+	}
+	if picture != nil {
+		profile.PictureURL = picture.URL
+		profile.PictureID = picture.ID
+	}
+
+	if ms.profileCache != nil {
+		if err := ms.profileCache.SaveContactProfile(userID, profile); err != nil {
+			logger.Warn("Falha ao salvar perfil no cache", "user_id", userID, "jid", profile.JID, "error", err)
+		}
+	}
+
+	logger.Debug("Perfil do contato obtido",
+		"user_id", userID,
+		"jid", profile.JID,
+		"has_picture", profile.PictureURL != "",
+		"is_business", profile.BusinessName != "")
+
+	return &ContactProfile{ContactProfile: profile}, nil
+}
+
+// InvalidateContactProfile remove o perfil do contato do cache, para que a próxima consulta vá ao WhatsApp
+func (ms *MessageService) InvalidateContactProfile(userID, jid string) {
+	if ms.profileCache == nil {
+		return
+	}
+	if err := ms.profileCache.DeleteContactProfile(userID, jid); err != nil {
+		logger.Warn("Falha ao remover perfil do cache", "user_id", userID, "jid", jid, "error", err)
+	}
+}
+
+// profileClient retorna o cliente conectado e autenticado da sessão
+func (ms *MessageService) profileClient(userID string) (*whatsmeow.Client, error) {
+	client, exists := ms.sessionManager.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+
+	if !client.Connected {
+		return nil, fmt.Errorf("cliente não está conectado")
+	}
+
+	if client.WAClient.Store.ID == nil {
+		return nil, fmt.Errorf("sessão não autenticada: %s", userID)
+	}
+
+	client.LastActive = time.Now()
+
+	return client.WAClient, nil
+}
+
+// getProfilePicture obtém a foto de perfil, retornando nil quando não existe ou está oculta pelo contato
+func getProfilePicture(waClient *whatsmeow.Client, jid types.JID) (*types.ProfilePictureInfo, error) {
+	picture, err := waClient.GetProfilePictureInfo(jid, &whatsmeow.GetProfilePictureParams{})
+	if errors.Is(err, whatsmeow.ErrProfilePictureNotSet) || errors.Is(err, whatsmeow.ErrProfilePictureUnauthorized) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter foto de perfil: %w", err)
+	}
+	return picture, nil
+}
@@ -0,0 +1,159 @@
+// internal/services/whatsapp/messaging/status.go
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/services/whatsapp/extensions"
+	"yourproject/pkg/logger"
+)
+
+// Cores usadas nos status de texto quando não informadas
+const (
+	DefaultStatusBackgroundColor = "#FF128C7E"
+	statusTextColor              = 0xFFFFFFFF
+)
+
+// PostTextStatus publica um status de texto com cor de fundo e fonte.
+// Com audience vazia, o status segue a privacidade de status da conta.
+func (ms *MessageService) PostTextStatus(userID, text, backgroundColor, font string, audience []string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("texto do status não pode estar vazio")
+	}
+
+	if backgroundColor == "" {
+		backgroundColor = DefaultStatusBackgroundColor
+	}
+	background, err := ParseStatusColor(backgroundColor)
+	if err != nil {
+		return "", err
+	}
+
+	fontType, err := ParseStatusFont(font)
+	if err != nil {
+		return "", err
+	}
+
+	message := &waE2E.Message{
+		ExtendedTextMessage: &waE2E.ExtendedTextMessage{
+			Text:           proto.String(text),
+			BackgroundArgb: proto.Uint32(background),
+			TextArgb:       proto.Uint32(statusTextColor),
+			Font:           fontType.Enum(),
+		},
+	}
+
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return "", err
+	}
+
+	return ms.postStatus(userID, waClient, "text", message, audience)
+}
+
+// PostMediaStatus publica um status de imagem ou vídeo a partir de uma URL.
+// Com audience vazia, o status segue a privacidade de status da conta.
+func (ms *MessageService) PostMediaStatus(userID, mediaURL, mediaType, caption string, audience []string) (string, error) {
+	var uploadType whatsmeow.MediaType
+	switch mediaType {
+	case "image":
+		uploadType = whatsmeow.MediaImage
+	case "video":
+		uploadType = whatsmeow.MediaVideo
+	default:
+		return "", fmt.Errorf("tipo de mídia não suportado em status: %s", mediaType)
+	}
+
+	waClient, err := ms.profileClient(userID)
+	if err != nil {
+		return "", err
+	}
+
+	media, err := uploadMediaFromURL(waClient, mediaURL, uploadType)
+	if err != nil {
+		return "", err
+	}
+
+	return ms.postStatus(userID, waClient, mediaType, media.visualMessage(caption), audience)
+}
+
+// postStatus envia o status para status@broadcast, restrito à audiência quando informada
+func (ms *MessageService) postStatus(userID string, waClient *whatsmeow.Client, statusType string, message *waE2E.Message, audience []string) (string, error) {
+	recipients := make([]types.JID, 0, len(audience))
+	for _, contact := range audience {
+		resolved, err := ms.resolveParticipantJID(userID, waClient, contact)
+		if err != nil {
+			return "", fmt.Errorf("destinatário inválido %q: %w", contact, err)
+		}
+		jid, err := types.ParseJID(resolved)
+		if err != nil {
+			return "", fmt.Errorf("JID inválido: %w", err)
+		}
+		recipients = append(recipients, jid)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var messageID string
+	if len(recipients) > 0 {
+		id, err := extensions.SendStatusToAudience(ctx, waClient, message, recipients)
+		if err != nil {
+			return "", fmt.Errorf("falha ao publicar status: %w", err)
+		}
+		messageID = id
+	} else {
+		resp, err := waClient.SendMessage(ctx, types.StatusBroadcastJID, message)
+		if err != nil {
+			return "", fmt.Errorf("falha ao publicar status: %w", err)
+		}
+		messageID = resp.ID
+	}
+
+	logger.Debug("Status publicado", "user_id", userID, "type", statusType, "audience", len(recipients), "message_id", messageID)
+
+	return messageID, nil
+}
+
+// ParseStatusColor converte uma cor "#RRGGBB" ou "#AARRGGBB" no formato ARGB usado pelo WhatsApp
+func ParseStatusColor(color string) (uint32, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(color), "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return 0, fmt.Errorf("cor inválida %q: use #RRGGBB ou #AARRGGBB", color)
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("cor inválida %q: use #RRGGBB ou #AARRGGBB", color)
+	}
+	if len(hex) == 6 {
+		value |= 0xFF000000
+	}
+
+	return uint32(value), nil
+}
+
+// ParseStatusFont converte o nome da fonte (ex.: "system", "fb_script") na fonte do WhatsApp.
+// Nome vazio usa a fonte padrão.
+func ParseStatusFont(font string) (waE2E.ExtendedTextMessage_FontType, error) {
+	if font == "" {
+		return waE2E.ExtendedTextMessage_SYSTEM, nil
+	}
+
+	value, ok := waE2E.ExtendedTextMessage_FontType_value[strings.ToUpper(strings.TrimSpace(font))]
+	if !ok {
+		return 0, fmt.Errorf("fonte inválida: %s", font)
+	}
+
+	return waE2E.ExtendedTextMessage_FontType(value), nil
+}
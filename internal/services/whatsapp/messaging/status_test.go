@@ -0,0 +1,127 @@
+package messaging
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+)
+
+func TestParseStatusColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected uint32
+		hasError bool
+	}{
+		{
+			name:     "Default background color",
+			input:    DefaultStatusBackgroundColor,
+			expected: 0xFF128C7E,
+			hasError: false,
+		},
+		{
+			name:     "RGB color is made opaque",
+			input:    "#128C7E",
+			expected: 0xFF128C7E,
+			hasError: false,
+		},
+		{
+			name:     "ARGB color keeps its alpha",
+			input:    "#80FF0000",
+			expected: 0x80FF0000,
+			hasError: false,
+		},
+		{
+			name:     "Color without # and with spaces",
+			input:    " 00ff00 ",
+			expected: 0xFF00FF00,
+			hasError: false,
+		},
+		{
+			name:     "Short color",
+			input:    "#FFF",
+			hasError: true,
+		},
+		{
+			name:     "Invalid hex digits",
+			input:    "#GG0000",
+			hasError: true,
+		},
+		{
+			name:     "Empty color",
+			input:    "",
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseStatusColor(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("For input %s, expected error, but got %#08X", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("For input %s, expected no error, but got %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("For input %s, expected %#08X, but got %#08X", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseStatusFont(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected waE2E.ExtendedTextMessage_FontType
+		hasError bool
+	}{
+		{
+			name:     "Empty font uses the default",
+			input:    "",
+			expected: waE2E.ExtendedTextMessage_SYSTEM,
+			hasError: false,
+		},
+		{
+			name:     "Lowercase font name",
+			input:    "fb_script",
+			expected: waE2E.ExtendedTextMessage_FB_SCRIPT,
+			hasError: false,
+		},
+		{
+			name:     "Font name with spaces",
+			input:    " System_Bold ",
+			expected: waE2E.ExtendedTextMessage_SYSTEM_BOLD,
+			hasError: false,
+		},
+		{
+			name:     "Unknown font",
+			input:    "comic_sans",
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseStatusFont(tt.input)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("For input %s, expected error, but got %s", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("For input %s, expected no error, but got %v", tt.input, err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("For input %s, expected %s, but got %s", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
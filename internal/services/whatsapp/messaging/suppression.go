@@ -0,0 +1,112 @@
+// internal/services/whatsapp/messaging/suppression.go
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/pkg/logger"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// ErrRecipientSuppressed é retornado quando o destinatário está na lista de supressão (opt-out)
+var ErrRecipientSuppressed = errors.New("destinatário na lista de supressão")
+
+// ErrCodeRecipientSuppressed é o código de erro exposto pela API e pelos eventos para envios recusados por opt-out
+const ErrCodeRecipientSuppressed = "RECIPIENT_SUPPRESSED"
+
+// SuppressionChecker verifica se algum dos números informados pediu para não receber mensagens da sessão
+type SuppressionChecker interface {
+	IsSuppressed(userID string, phones ...string) (bool, error)
+}
+
+// SetSuppressionChecker define a lista de supressão consultada antes de cada envio
+func (ms *MessageService) SetSuppressionChecker(checker SuppressionChecker) {
+	ms.suppressionChecker = checker
+}
+
+// SetSuppressionChecker define a lista de supressão consultada antes de enviar convites de grupo
+func (gs *GroupService) SetSuppressionChecker(checker SuppressionChecker) {
+	gs.suppressionChecker = checker
+}
+
+// IsSuppressedError retorna se o erro foi causado por um destinatário na lista de supressão
+func IsSuppressedError(err error) bool {
+	return errors.Is(err, ErrRecipientSuppressed)
+}
+
+// checkSuppression recusa o envio se o destinatário estiver na lista de supressão.
+// Grupos, newsletters e listas de transmissão não são verificados.
+func (ms *MessageService) checkSuppression(userID string, recipient types.JID) error {
+	var waClient *whatsmeow.Client
+	if client, exists := ms.sessionManager.GetSession(userID); exists {
+		waClient = client.WAClient
+	}
+	return checkRecipientSuppression(ms.suppressionChecker, waClient, userID, recipient)
+}
+
+// checkRecipientSuppression consulta a lista de supressão para qualquer serviço que envie mensagens.
+// Contatos endereçados por LID são verificados pelo número de telefone associado.
+func checkRecipientSuppression(checker SuppressionChecker, waClient *whatsmeow.Client, userID string, recipient types.JID) error {
+	if checker == nil {
+		return nil
+	}
+
+	switch recipient.Server {
+	case types.DefaultUserServer:
+		// Número de telefone: consultado diretamente
+	case types.HiddenUserServer:
+		pn, err := phoneForLID(waClient, recipient)
+		if err != nil {
+			// Na dúvida não enviar: sem o número não há como consultar o opt-out
+			return fmt.Errorf("falha ao consultar lista de supressão: %w", err)
+		}
+		recipient = pn
+	default:
+		return nil
+	}
+
+	// O número pode estar salvo em outra forma (ex.: com ou sem o nono dígito no Brasil)
+	phones := []string{recipient.User}
+	if number, err := phone.Parse("+"+recipient.User, ""); err == nil {
+		phones = number.Candidates()
+	}
+
+	suppressed, err := checker.IsSuppressed(userID, phones...)
+	if err != nil {
+		// Na dúvida não enviar: um opt-out ignorado é pior que um envio adiado
+		return fmt.Errorf("falha ao consultar lista de supressão: %w", err)
+	}
+
+	if suppressed {
+		logger.Info("Envio recusado para destinatário com opt-out", "user_id", userID, "recipient", recipient.User)
+		return fmt.Errorf("%w: %s", ErrRecipientSuppressed, recipient.User)
+	}
+
+	return nil
+}
+
+// phoneForLID retorna o JID de número de telefone associado a um LID
+func phoneForLID(waClient *whatsmeow.Client, lid types.JID) (types.JID, error) {
+	if waClient == nil || waClient.Store == nil || waClient.Store.LIDs == nil {
+		return types.JID{}, fmt.Errorf("sessão indisponível para resolver o LID %s", lid.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pn, err := waClient.Store.LIDs.GetPNForLID(ctx, lid.ToNonAD())
+	if err != nil {
+		return types.JID{}, fmt.Errorf("falha ao resolver o LID %s: %w", lid.String(), err)
+	}
+	if pn.IsEmpty() {
+		return types.JID{}, fmt.Errorf("número de telefone do LID %s desconhecido", lid.String())
+	}
+
+	return pn, nil
+}
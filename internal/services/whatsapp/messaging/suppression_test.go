@@ -0,0 +1,82 @@
+package messaging
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// fakeSuppressionChecker trata como suprimidos os números informados
+type fakeSuppressionChecker map[string]bool
+
+func (f fakeSuppressionChecker) IsSuppressed(userID string, phones ...string) (bool, error) {
+	for _, p := range phones {
+		if f[p] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestCheckRecipientSuppression(t *testing.T) {
+	checker := fakeSuppressionChecker{"5511988376411": true}
+
+	tests := []struct {
+		name           string
+		input          string
+		expectedError  bool
+		expectedOptOut bool
+	}{
+		{
+			name:           "Suppressed phone number",
+			input:          "5511988376411@s.whatsapp.net",
+			expectedError:  true,
+			expectedOptOut: true,
+		},
+		{
+			name:           "Suppressed number stored with the ninth digit",
+			input:          "551188376411@s.whatsapp.net",
+			expectedError:  true,
+			expectedOptOut: true,
+		},
+		{
+			name:  "Number not suppressed",
+			input: "5511912345678@s.whatsapp.net",
+		},
+		{
+			name:           "LID without a session cannot be checked",
+			input:          "123456789012345@lid",
+			expectedError:  true,
+			expectedOptOut: false,
+		},
+		{
+			name:  "Group is not checked",
+			input: "120363123456789012@g.us",
+		},
+		{
+			name:  "Newsletter is not checked",
+			input: "123456789@newsletter",
+		},
+		{
+			name:  "Status broadcast is not checked",
+			input: "status@broadcast",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipient, err := types.ParseJID(tt.input)
+			if err != nil {
+				t.Fatalf("ParseJID(%s) error = %v", tt.input, err)
+			}
+
+			err = checkRecipientSuppression(checker, nil, "user", recipient)
+			if (err != nil) != tt.expectedError {
+				t.Errorf("For input %s, expected error %v, but got %v", tt.input, tt.expectedError, err)
+			}
+			if IsSuppressedError(err) != tt.expectedOptOut {
+				t.Errorf("For input %s, expected opt-out %v, but got %v", tt.input, tt.expectedOptOut, err)
+			}
+		})
+	}
+}
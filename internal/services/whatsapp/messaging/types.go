@@ -150,6 +150,9 @@ type GroupInfo struct {
 	
 	// Status e permissões do usuário atual
 	UserPermissions  UserGroupPermissions `json:"user_permissions"`
+
+	// Horário da cópia em cache, ausente quando consultado ao vivo
+	CachedAt         *time.Time           `json:"cached_at,omitempty"`
 	
 	// Campos de compatibilidade (legacy)
 	Name     string `json:"name"`      // Alias para NameInfo.Name
@@ -184,6 +187,54 @@ type GroupUpdateResult struct {
 	FailedUpdates     map[string]string `json:"failed_updates"`
 }
 
+// GroupParticipantResult representa o resultado da alteração de um participante do grupo
+type GroupParticipantResult struct {
+	Input       string `json:"input"`
+	JID         string `json:"jid,omitempty"`
+	Success     bool   `json:"success"`
+	Status      int    `json:"status,omitempty"`
+	Error       string `json:"error,omitempty"`
+	InviteSent  bool   `json:"invite_sent,omitempty"`
+	InviteError string `json:"invite_error,omitempty"`
+
+	// Convite retornado pelo WhatsApp quando a privacidade impede a adição
+	inviteCode       string
+	inviteExpiration time.Time
+}
+
+// GroupParticipantsUpdateResult representa o resultado de uma alteração de participantes em lote
+type GroupParticipantsUpdateResult struct {
+	GroupJID    string                   `json:"group_jid"`
+	Action      string                   `json:"action"`
+	Results     []GroupParticipantResult `json:"results"`
+	Succeeded   int                      `json:"succeeded"`
+	Failed      int                      `json:"failed"`
+	InvitesSent int                      `json:"invites_sent"`
+}
+
+// GroupJoinRequest representa uma solicitação pendente de entrada no grupo
+type GroupJoinRequest struct {
+	JID         string    `json:"jid"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// GroupInvitePreview representa as informações de um grupo obtidas pelo link de convite, sem entrar nele
+type GroupInvitePreview struct {
+	JID                    string     `json:"jid"`
+	InviteCode             string     `json:"invite_code"`
+	Name                   string     `json:"name"`
+	Topic                  string     `json:"topic,omitempty"`
+	OwnerJID               string     `json:"owner_jid,omitempty"`
+	Created                *time.Time `json:"created,omitempty"`
+	Size                   int        `json:"size"`
+	IsCommunity            bool       `json:"is_community"`
+	CommunityJID           string     `json:"community_jid,omitempty"`
+	IsDefaultSubGroup      bool       `json:"is_default_sub_group"`
+	IsAnnounce             bool       `json:"is_announce"`
+	IsJoinApprovalRequired bool       `json:"is_join_approval_required"`
+	IsMember               bool       `json:"is_member"`
+}
+
 // CommunityInfo representa informações de uma comunidade
 type CommunityInfo struct {
 	JID                string      `json:"jid"`
@@ -209,6 +260,10 @@ func ToGroupInfo(waGroupInfo *types.GroupInfo, currentUserJID string) *GroupInfo
 		IsSuperAdmin:  false,
 	}
 
+	// O JID da sessão inclui o dispositivo, e grupos com LID identificam o participante pelo LID
+	selfJID, _ := types.ParseJID(currentUserJID)
+	selfJID = selfJID.ToNonAD()
+
 	for i, p := range waGroupInfo.Participants {
 		participant := GroupParticipant{
 			JID:          p.JID.String(),
@@ -235,7 +290,7 @@ func ToGroupInfo(waGroupInfo *types.GroupInfo, currentUserJID string) *GroupInfo
 		}
 
 		// Verificar se é o usuário atual
-		if p.JID.String() == currentUserJID {
+		if p.JID.String() == currentUserJID || (!selfJID.IsEmpty() && (p.JID.ToNonAD() == selfJID || p.PhoneNumber.ToNonAD() == selfJID)) {
 			userPermissions.IsParticipant = true
 			userPermissions.IsAdmin = p.IsAdmin
 			userPermissions.IsSuperAdmin = p.IsSuperAdmin
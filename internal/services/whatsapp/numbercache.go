@@ -0,0 +1,77 @@
+// internal/services/whatsapp/numbercache.go
+package whatsapp
+
+import (
+	"fmt"
+	"time"
+
+	"yourproject/internal/services/whatsapp/messaging"
+	"yourproject/pkg/logger"
+)
+
+// numberCachePurgeInterval is how often expired number lookups are removed from the database
+const numberCachePurgeInterval = time.Hour
+
+// SetNumberCacheTTL configures how long IsOnWhatsApp results are reused.
+// Zero values keep the defaults.
+func (sm *SessionManager) SetNumberCacheTTL(positiveTTL, negativeTTL time.Duration) {
+	if positiveTTL <= 0 {
+		positiveTTL = messaging.DefaultNumberCachePositiveTTL
+	}
+	if negativeTTL <= 0 {
+		negativeTTL = messaging.DefaultNumberCacheNegativeTTL
+	}
+
+	sm.coordinator.messageService.SetNumberCache(sm.sqlStore, positiveTTL, negativeTTL)
+	sm.coordinator.groupService.SetNumberCache(sm.sqlStore, positiveTTL, negativeTTL)
+	sm.coordinator.communityService.SetNumberCache(sm.sqlStore, positiveTTL, negativeTTL)
+	sm.coordinator.newsletterService.SetNumberCache(sm.sqlStore, positiveTTL, negativeTTL)
+
+	maxAge := positiveTTL
+	if negativeTTL > maxAge {
+		maxAge = negativeTTL
+	}
+	sm.numberCacheMaxAge.Store(int64(maxAge))
+
+	logger.Debug("Cache de números configurado", "positive_ttl", positiveTTL, "negative_ttl", negativeTTL)
+}
+
+// CheckNumbers verifies several phone numbers on WhatsApp, reusing cached lookups
+func (sm *SessionManager) CheckNumbers(userID string, numbers []string) ([]messaging.NumberCheckResult, error) {
+	client, exists := sm.GetSession(userID)
+	if !exists {
+		return nil, fmt.Errorf("sessão não encontrada: %s", userID)
+	}
+	if !client.Connected {
+		return nil, fmt.Errorf("cliente não está conectado")
+	}
+
+	return sm.coordinator.messageService.CheckNumbersOnWhatsApp(userID, numbers)
+}
+
+// ClearNumberCache removes every cached number lookup of a session
+func (sm *SessionManager) ClearNumberCache(userID string) (int64, error) {
+	return sm.sqlStore.ClearNumberLookups(userID)
+}
+
+// purgeNumberCache periodically removes lookups that can no longer be reused
+func (sm *SessionManager) purgeNumberCache() {
+	ticker := time.NewTicker(numberCachePurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sm.done:
+			return
+		case <-ticker.C:
+			removed, err := sm.sqlStore.PurgeNumberLookups(time.Now().Add(-time.Duration(sm.numberCacheMaxAge.Load())))
+			if err != nil {
+				logger.Warn("Falha ao limpar cache de números", "error", err)
+				continue
+			}
+			if removed > 0 {
+				logger.Debug("Cache de números limpo", "removed", removed)
+			}
+		}
+	}
+}
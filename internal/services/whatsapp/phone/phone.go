@@ -0,0 +1,291 @@
+// internal/services/whatsapp/phone/phone.go
+package phone
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// DefaultRegion é a região usada quando a sessão não define uma
+const DefaultRegion = "BR"
+
+// Limites de tamanho de um número E.164 (código do país + número nacional)
+const (
+	minDigits         = 8
+	maxDigits         = 15
+	minNationalDigits = 6
+)
+
+// ErrorCode identifica o motivo pelo qual um número foi rejeitado
+type ErrorCode string
+
+const (
+	ErrCodeEmpty             ErrorCode = "empty"
+	ErrCodeInvalidCharacters ErrorCode = "invalid_characters"
+	ErrCodeTooShort          ErrorCode = "too_short"
+	ErrCodeTooLong           ErrorCode = "too_long"
+	ErrCodeInvalidForRegion  ErrorCode = "invalid_for_region"
+	ErrCodeUnknownRegion     ErrorCode = "unknown_region"
+)
+
+// Error descreve por que um número de telefone não pôde ser normalizado
+type Error struct {
+	Input  string    `json:"input"`
+	Code   ErrorCode `json:"code"`
+	Region string    `json:"region,omitempty"`
+	Reason string    `json:"reason"`
+}
+
+func (e *Error) Error() string {
+	if e.Region != "" {
+		return fmt.Sprintf("número inválido %q (%s): %s", e.Input, e.Region, e.Reason)
+	}
+	return fmt.Sprintf("número inválido %q: %s", e.Input, e.Reason)
+}
+
+// Rule encapsula as particularidades de numeração de um país
+type Rule interface {
+	// Region retorna o código ISO 3166 da região (ex.: "BR")
+	Region() string
+	// CountryCode retorna o código de discagem internacional (ex.: "55")
+	CountryCode() string
+	// IsNational indica se dígitos sem código do país parecem um número nacional da região
+	IsNational(digits string) bool
+	// Normalize converte o número nacional na forma usada pelo WhatsApp ou explica por que é inválido
+	Normalize(national string) (string, error)
+	// Variants retorna outras formas nacionais sob as quais o mesmo assinante pode estar registrado
+	Variants(national string) []string
+}
+
+var (
+	rulesMu         sync.RWMutex
+	rulesByRegion   = make(map[string]Rule)
+	rulesByCode     = make(map[string]Rule)
+	countryCodesLen []int
+)
+
+// Register adiciona ou substitui a regra de um país
+func Register(rule Rule) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rulesByRegion[strings.ToUpper(rule.Region())] = rule
+	rulesByCode[rule.CountryCode()] = rule
+
+	// Manter os tamanhos de código do país em ordem decrescente para casar o prefixo mais longo
+	seen := make(map[int]bool)
+	countryCodesLen = countryCodesLen[:0]
+	for code := range rulesByCode {
+		if !seen[len(code)] {
+			seen[len(code)] = true
+			countryCodesLen = append(countryCodesLen, len(code))
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(countryCodesLen)))
+}
+
+// Lookup retorna a regra registrada para a região
+func Lookup(region string) (Rule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	rule, ok := rulesByRegion[strings.ToUpper(region)]
+	return rule, ok
+}
+
+// IsSupportedRegion indica se existe regra para a região
+func IsSupportedRegion(region string) bool {
+	_, ok := Lookup(region)
+	return ok
+}
+
+// Regions retorna as regiões com regra registrada, em ordem alfabética
+func Regions() []string {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	regions := make([]string, 0, len(rulesByRegion))
+	for region := range rulesByRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// ruleForDigits retorna a regra cujo código do país prefixa os dígitos
+func ruleForDigits(digits string) (Rule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	for _, size := range countryCodesLen {
+		if len(digits) <= size {
+			continue
+		}
+		if rule, ok := rulesByCode[digits[:size]]; ok {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+// Number é um número de telefone normalizado
+type Number struct {
+	CountryCode string `json:"country_code,omitempty"`
+	National    string `json:"national"`
+	Region      string `json:"region,omitempty"`
+
+	variants []string
+}
+
+// Digits retorna código do país e número nacional, sem "+" (forma usada nos JIDs)
+func (n Number) Digits() string {
+	return n.CountryCode + n.National
+}
+
+// E164 retorna o número no formato E.164
+func (n Number) E164() string {
+	return "+" + n.Digits()
+}
+
+// JID retorna o JID de usuário do WhatsApp correspondente ao número
+func (n Number) JID() string {
+	return types.NewJID(n.Digits(), types.DefaultUserServer).String()
+}
+
+// Candidates retorna o número e suas variantes (ex.: com e sem o nono dígito) sem "+"
+func (n Number) Candidates() []string {
+	candidates := []string{n.Digits()}
+	for _, variant := range n.variants {
+		candidates = append(candidates, n.CountryCode+variant)
+	}
+	return candidates
+}
+
+// Parse normaliza um número de telefone.
+// Números com "+" ou "00" são tratados como internacionais; os demais podem ser
+// nacionais da região padrão (ex.: "11988376411" com região "BR").
+func Parse(input, defaultRegion string) (Number, error) {
+	original := input
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return Number{}, &Error{Input: original, Code: ErrCodeEmpty, Reason: "número não pode ser vazio"}
+	}
+
+	digits, international, err := clean(input)
+	if err != nil {
+		err.Input = original
+		return Number{}, err
+	}
+
+	if len(digits) < minNationalDigits {
+		return Number{}, &Error{Input: original, Code: ErrCodeTooShort, Reason: fmt.Sprintf("número muito curto (%d dígitos)", len(digits))}
+	}
+
+	var regionRule Rule
+	if defaultRegion != "" {
+		rule, ok := Lookup(defaultRegion)
+		if !ok {
+			return Number{}, &Error{Input: original, Code: ErrCodeUnknownRegion, Region: defaultRegion, Reason: "região padrão não suportada"}
+		}
+		regionRule = rule
+	}
+
+	if !international && regionRule != nil {
+		code := regionRule.CountryCode()
+		rest := strings.TrimPrefix(digits, code)
+		hasCode := len(rest) < len(digits)
+
+		switch {
+		case hasCode && regionRule.IsNational(rest):
+			// Já inclui o código do país da região
+			return build(original, regionRule, rest)
+		case regionRule.IsNational(digits):
+			// Número nacional, sem código do país
+			return build(original, regionRule, digits)
+		}
+	}
+
+	// Internacional: identificar o país pelo prefixo
+	if rule, ok := ruleForDigits(digits); ok {
+		return build(original, rule, digits[len(rule.CountryCode()):])
+	}
+
+	if len(digits) < minDigits {
+		return Number{}, &Error{Input: original, Code: ErrCodeTooShort, Reason: fmt.Sprintf("número muito curto (%d dígitos)", len(digits))}
+	}
+	if len(digits) > maxDigits {
+		return Number{}, &Error{Input: original, Code: ErrCodeTooLong, Reason: fmt.Sprintf("número muito longo (%d dígitos, máximo %d)", len(digits), maxDigits)}
+	}
+
+	// País sem regra específica: manter os dígitos como informados
+	return Number{National: digits}, nil
+}
+
+// Normalize retorna o número no formato E.164
+func Normalize(input, defaultRegion string) (string, error) {
+	number, err := Parse(input, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return number.E164(), nil
+}
+
+// build aplica a regra do país ao número nacional
+func build(input string, rule Rule, national string) (Number, error) {
+	normalized, err := rule.Normalize(national)
+	if err != nil {
+		return Number{}, &Error{Input: input, Code: ErrCodeInvalidForRegion, Region: rule.Region(), Reason: err.Error()}
+	}
+
+	total := len(rule.CountryCode()) + len(normalized)
+	if total < minDigits {
+		return Number{}, &Error{Input: input, Code: ErrCodeTooShort, Region: rule.Region(), Reason: fmt.Sprintf("número muito curto (%d dígitos)", total)}
+	}
+	if total > maxDigits {
+		return Number{}, &Error{Input: input, Code: ErrCodeTooLong, Region: rule.Region(), Reason: fmt.Sprintf("número muito longo (%d dígitos, máximo %d)", total, maxDigits)}
+	}
+
+	number := Number{
+		CountryCode: rule.CountryCode(),
+		National:    normalized,
+		Region:      rule.Region(),
+	}
+	for _, variant := range rule.Variants(normalized) {
+		if variant != normalized {
+			number.variants = append(number.variants, variant)
+		}
+	}
+
+	return number, nil
+}
+
+// clean remove a formatação e indica se o número foi informado como internacional
+func clean(input string) (string, bool, *Error) {
+	international := false
+	switch {
+	case strings.HasPrefix(input, "+"):
+		international = true
+		input = input[1:]
+	case strings.HasPrefix(input, "00"):
+		international = true
+		input = input[2:]
+	}
+
+	var digits strings.Builder
+	for _, r := range input {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.' || r == '/' || r == '\t':
+			continue
+		default:
+			return "", false, &Error{Input: input, Code: ErrCodeInvalidCharacters, Reason: fmt.Sprintf("caractere inválido %q", r)}
+		}
+	}
+
+	return digits.String(), international, nil
+}
@@ -0,0 +1,258 @@
+// internal/services/whatsapp/phone/rules.go
+package phone
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	Register(brazilRule{})
+	Register(mexicoRule{})
+	Register(argentinaRule{})
+
+	// Países sem particularidades além do tamanho do número nacional
+	Register(fixedRule{region: "US", code: "1", min: 10, max: 10})
+	Register(fixedRule{region: "PT", code: "351", min: 9, max: 9})
+	Register(fixedRule{region: "ES", code: "34", min: 9, max: 9})
+	Register(fixedRule{region: "CO", code: "57", min: 10, max: 10})
+	Register(fixedRule{region: "CL", code: "56", min: 9, max: 9})
+	Register(fixedRule{region: "PE", code: "51", min: 9, max: 9})
+	Register(fixedRule{region: "UY", code: "598", min: 8, max: 8, trunk: "0"})
+	Register(fixedRule{region: "PY", code: "595", min: 9, max: 9, trunk: "0"})
+	Register(fixedRule{region: "GB", code: "44", min: 10, max: 10, trunk: "0"})
+	Register(fixedRule{region: "FR", code: "33", min: 9, max: 9, trunk: "0"})
+	Register(fixedRule{region: "DE", code: "49", min: 6, max: 13, trunk: "0"})
+	Register(fixedRule{region: "IT", code: "39", min: 6, max: 11})
+	Register(fixedRule{region: "IN", code: "91", min: 10, max: 10, trunk: "0"})
+}
+
+// fixedRule valida apenas o tamanho do número nacional e remove o prefixo de tronco
+type fixedRule struct {
+	region string
+	code   string
+	min    int
+	max    int
+	trunk  string
+}
+
+func (r fixedRule) Region() string      { return r.region }
+func (r fixedRule) CountryCode() string { return r.code }
+
+func (r fixedRule) IsNational(digits string) bool {
+	digits = r.stripTrunk(digits)
+	return len(digits) >= r.min && len(digits) <= r.max
+}
+
+func (r fixedRule) Normalize(national string) (string, error) {
+	national = r.stripTrunk(national)
+	if len(national) < r.min || len(national) > r.max {
+		if r.min == r.max {
+			return "", fmt.Errorf("o número nacional deve ter %d dígitos, recebido %d", r.min, len(national))
+		}
+		return "", fmt.Errorf("o número nacional deve ter entre %d e %d dígitos, recebido %d", r.min, r.max, len(national))
+	}
+	return national, nil
+}
+
+func (r fixedRule) Variants(national string) []string { return nil }
+
+func (r fixedRule) stripTrunk(digits string) string {
+	if r.trunk != "" && len(digits) > r.min {
+		return strings.TrimPrefix(digits, r.trunk)
+	}
+	return digits
+}
+
+// brazilRule trata DDDs e o nono dígito dos celulares brasileiros.
+// Contas antigas do WhatsApp continuam registradas sem o nono dígito, por isso
+// o número é mantido como informado e a outra forma é oferecida como variante.
+type brazilRule struct{}
+
+// brazilAreaCodes lista os DDDs válidos
+var brazilAreaCodes = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true,
+	"27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true,
+	"47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+func (brazilRule) Region() string      { return "BR" }
+func (brazilRule) CountryCode() string { return "55" }
+
+// IsNational reconhece DDD + 8 dígitos (fixo ou celular antigo) ou DDD + 9 + 8 dígitos
+func (brazilRule) IsNational(digits string) bool {
+	digits = strings.TrimPrefix(digits, "0")
+	if len(digits) != 10 && len(digits) != 11 {
+		return false
+	}
+	if !brazilAreaCodes[digits[:2]] {
+		return false
+	}
+
+	// Números americanos digitados sem "+" (1-555-...) colidem com o DDD 15
+	if digits[:2] == "15" && digits[2:4] == "55" {
+		return false
+	}
+
+	// Com 11 dígitos o número precisa ser celular (nono dígito)
+	return len(digits) == 10 || digits[2] == '9'
+}
+
+func (brazilRule) Normalize(national string) (string, error) {
+	national = strings.TrimPrefix(national, "0")
+
+	// Números com DDD + 7 dígitos são mantidos, embora incompletos: o WhatsApp pode tê-los registrado assim.
+	// Sem DDD o número não é aceito.
+	if len(national) < 9 || len(national) > 11 {
+		return "", fmt.Errorf("números brasileiros devem ter DDD + 8 ou 9 dígitos, recebido %d dígitos", len(national))
+	}
+
+	if !brazilAreaCodes[national[:2]] {
+		return "", fmt.Errorf("DDD %s inexistente", national[:2])
+	}
+
+	return national, nil
+}
+
+// Variants alterna o nono dígito dos celulares
+func (brazilRule) Variants(national string) []string {
+	switch len(national) {
+	case 11:
+		// DDD + 9 + 8 dígitos: tentar sem o nono dígito
+		if national[2] == '9' {
+			return []string{national[:2] + national[3:]}
+		}
+	case 10:
+		// DDD + 8 dígitos de celular (começando com 6-9): tentar com o nono dígito
+		if national[2] >= '6' && national[2] <= '9' {
+			return []string{national[:2] + "9" + national[2:]}
+		}
+	case 9:
+		// DDD + 7 dígitos, provavelmente faltando o nono dígito
+		return []string{national[:2] + "9" + national[2:]}
+	}
+	return nil
+}
+
+// RemoveBrazilianNinthDigit remove o nono dígito de um celular brasileiro completo (55 + DDD + 9 dígitos)
+func RemoveBrazilianNinthDigit(number string) string {
+	if strings.HasPrefix(number, "55") && len(number) == 13 && number[4] == '9' {
+		return number[:4] + number[5:]
+	}
+	return number
+}
+
+// AddBrazilianNinthDigit adiciona o nono dígito a um celular brasileiro no formato antigo (55 + DDD + 8 dígitos)
+func AddBrazilianNinthDigit(number string) string {
+	if strings.HasPrefix(number, "55") && len(number) == 12 && number[4] >= '6' && number[4] <= '8' {
+		return number[:4] + "9" + number[4:]
+	}
+	return number
+}
+
+// mexicoRule trata o "1" que o WhatsApp ainda usa após o código do país em celulares mexicanos.
+// Desde 2019 o formato E.164 não tem o "1"; contas antigas continuam registradas como 521.
+type mexicoRule struct{}
+
+func (mexicoRule) Region() string      { return "MX" }
+func (mexicoRule) CountryCode() string { return "52" }
+
+func (r mexicoRule) IsNational(digits string) bool {
+	_, err := r.Normalize(digits)
+	return err == nil
+}
+
+func (mexicoRule) Normalize(national string) (string, error) {
+	switch {
+	case len(national) == 13 && (strings.HasPrefix(national, "044") || strings.HasPrefix(national, "045")):
+		// Prefixo de celular da discagem nacional antiga
+		national = national[3:]
+	case len(national) == 12 && strings.HasPrefix(national, "01"):
+		// Prefixo de longa distância nacional extinto
+		national = national[2:]
+	case len(national) == 11 && strings.HasPrefix(national, "1"):
+		// "1" de celular usado antes de 2019
+		national = national[1:]
+	}
+
+	if len(national) != 10 {
+		return "", fmt.Errorf("números mexicanos devem ter 10 dígitos após o código do país, recebido %d", len(national))
+	}
+	return national, nil
+}
+
+// Variants inclui a forma com "1", usada pelas contas antigas
+func (mexicoRule) Variants(national string) []string {
+	return []string{"1" + national}
+}
+
+// argentinaRule trata o "9" internacional e o "15" local dos celulares argentinos.
+// O WhatsApp registra celulares como 54 9 + código de área + número (10 dígitos).
+type argentinaRule struct{}
+
+func (argentinaRule) Region() string      { return "AR" }
+func (argentinaRule) CountryCode() string { return "54" }
+
+func (r argentinaRule) IsNational(digits string) bool {
+	_, err := r.Normalize(digits)
+	return err == nil
+}
+
+func (argentinaRule) Normalize(national string) (string, error) {
+	national = strings.TrimPrefix(national, "0")
+
+	switch len(national) {
+	case 11:
+		// 9 + código de área + número: formato internacional de celular
+		if national[0] == '9' {
+			return national, nil
+		}
+	case 12:
+		// Código de área + 15 + número: celular discado localmente
+		if mobile, ok := argentinaStripFifteen(national); ok {
+			return "9" + mobile, nil
+		}
+	case 10:
+		// Código de área + número: fixo ou celular sem o 9
+		return national, nil
+	}
+
+	return "", fmt.Errorf("números argentinos devem ter código de área + número (10 dígitos), com 9 ou 15 para celulares; recebido %d dígitos", len(national))
+}
+
+// Variants alterna entre as formas com e sem o 9 de celular
+func (argentinaRule) Variants(national string) []string {
+	switch {
+	case len(national) == 11 && national[0] == '9':
+		return []string{national[1:]}
+	case len(national) == 10:
+		return []string{"9" + national}
+	}
+	return nil
+}
+
+// argentinaStripFifteen remove o "15" que segue o código de área (2 a 4 dígitos) em celulares
+func argentinaStripFifteen(national string) (string, bool) {
+	// Buenos Aires é o único código de área com 2 dígitos
+	if strings.HasPrefix(national, "11") {
+		if national[2:4] == "15" {
+			return national[:2] + national[4:], true
+		}
+		return "", false
+	}
+
+	for _, size := range []int{3, 4} {
+		if national[size:size+2] == "15" {
+			return national[:size] + national[size+2:], true
+		}
+	}
+	return "", false
+}
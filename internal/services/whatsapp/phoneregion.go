@@ -0,0 +1,78 @@
+// internal/services/whatsapp/phoneregion.go
+package whatsapp
+
+import (
+	"fmt"
+	"strings"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/pkg/logger"
+)
+
+// phoneRegionSettingKey is the session_settings key holding the default phone region
+const phoneRegionSettingKey = "phone_region"
+
+// PhoneRegionStatus describes the default phone region of a session
+type PhoneRegionStatus struct {
+	Region    string   `json:"region"`
+	IsDefault bool     `json:"is_default"`
+	Supported []string `json:"supported"`
+}
+
+// DefaultPhoneRegion returns the region used to parse numbers without a country code.
+// It is resolved on every send, so the value is cached after the first lookup.
+func (sm *SessionManager) DefaultPhoneRegion(userID string) string {
+	if region, ok := sm.phoneRegions.Load(userID); ok {
+		return region.(string)
+	}
+
+	region, _, err := sm.sqlStore.GetSessionSetting(userID, phoneRegionSettingKey)
+	if err != nil {
+		logger.Warn("Falha ao carregar região padrão de telefone", "user_id", userID, "error", err)
+		return phone.DefaultRegion
+	}
+	if region == "" {
+		region = phone.DefaultRegion
+	}
+
+	sm.phoneRegions.Store(userID, region)
+	return region
+}
+
+// GetPhoneRegion returns the default phone region of a session and the supported regions
+func (sm *SessionManager) GetPhoneRegion(userID string) (PhoneRegionStatus, error) {
+	region, found, err := sm.sqlStore.GetSessionSetting(userID, phoneRegionSettingKey)
+	if err != nil {
+		return PhoneRegionStatus{}, fmt.Errorf("falha ao carregar região padrão: %w", err)
+	}
+	if !found || region == "" {
+		region = phone.DefaultRegion
+	}
+
+	return PhoneRegionStatus{
+		Region:    region,
+		IsDefault: !found,
+		Supported: phone.Regions(),
+	}, nil
+}
+
+// SetPhoneRegion validates and persists the default phone region of a session
+func (sm *SessionManager) SetPhoneRegion(userID, region string) (PhoneRegionStatus, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if !phone.IsSupportedRegion(region) {
+		return PhoneRegionStatus{}, fmt.Errorf("região não suportada: %s (suportadas: %s)", region, strings.Join(phone.Regions(), ", "))
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, phoneRegionSettingKey, region); err != nil {
+		return PhoneRegionStatus{}, fmt.Errorf("falha ao salvar região padrão: %w", err)
+	}
+	sm.phoneRegions.Store(userID, region)
+
+	logger.Info("Região padrão de telefone atualizada", "user_id", userID, "region", region)
+
+	return PhoneRegionStatus{
+		Region:    region,
+		IsDefault: false,
+		Supported: phone.Regions(),
+	}, nil
+}
@@ -0,0 +1,60 @@
+// internal/services/whatsapp/profilecache.go
+package whatsapp
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/internal/services/whatsapp/messaging"
+	"yourproject/pkg/logger"
+)
+
+// profileCachePurgeInterval is how often expired contact profiles are removed from the database
+const profileCachePurgeInterval = time.Hour
+
+// SetProfileCacheTTL configures how long contact profiles are reused.
+// Zero keeps the default.
+func (sm *SessionManager) SetProfileCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = messaging.DefaultProfileCacheTTL
+	}
+
+	sm.coordinator.messageService.SetProfileCache(sm.sqlStore, ttl)
+	sm.profileCacheTTL.Store(int64(ttl))
+
+	logger.Debug("Cache de perfis configurado", "ttl", ttl)
+}
+
+// handleProfileCacheEvent drops the cached profile of a contact whose picture or about text changed
+func (sm *SessionManager) handleProfileCacheEvent(userID string, evt interface{}) error {
+	switch typedEvt := evt.(type) {
+	case *events.Picture:
+		sm.coordinator.messageService.InvalidateContactProfile(userID, typedEvt.JID.ToNonAD().String())
+	case *events.UserAbout:
+		sm.coordinator.messageService.InvalidateContactProfile(userID, typedEvt.JID.ToNonAD().String())
+	}
+	return nil
+}
+
+// purgeProfileCache periodically removes profiles that can no longer be reused
+func (sm *SessionManager) purgeProfileCache() {
+	ticker := time.NewTicker(profileCachePurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sm.done:
+			return
+		case <-ticker.C:
+			removed, err := sm.sqlStore.PurgeContactProfiles(time.Now().Add(-time.Duration(sm.profileCacheTTL.Load())))
+			if err != nil {
+				logger.Warn("Falha ao limpar cache de perfis", "error", err)
+				continue
+			}
+			if removed > 0 {
+				logger.Debug("Cache de perfis limpo", "removed", removed)
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// internal/services/whatsapp/ratelimit.go
+package whatsapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"yourproject/internal/services/whatsapp/worker"
+	"yourproject/pkg/logger"
+)
+
+// rateLimitSettingKey is the session_settings key holding the rate limit config
+const rateLimitSettingKey = "rate_limit"
+
+// GetRateLimit returns the rate limit config, warm-up state and metrics of a session
+func (sm *SessionManager) GetRateLimit(userID string) (worker.RateLimitStatus, error) {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("worker pool not available")
+	}
+
+	return workerPool.GetRateLimiter(userID).Status(), nil
+}
+
+// SetRateLimit validates, persists and applies a rate limit config to a session
+func (sm *SessionManager) SetRateLimit(userID string, config worker.RateLimitConfig) (worker.RateLimitStatus, error) {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("worker pool not available")
+	}
+
+	if err := config.Validate(); err != nil {
+		return worker.RateLimitStatus{}, err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("falha ao serializar limites: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, rateLimitSettingKey, string(data)); err != nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("falha ao salvar limites: %w", err)
+	}
+
+	if err := workerPool.SetRateLimitConfig(userID, config); err != nil {
+		return worker.RateLimitStatus{}, err
+	}
+
+	logger.Info("Limites de envio atualizados",
+		"user_id", userID,
+		"messages_per_minute", config.MessagesPerMinute,
+		"warmup_enabled", config.WarmupEnabled)
+
+	return workerPool.GetRateLimiter(userID).Status(), nil
+}
+
+// ResetRateLimit removes the custom rate limit of a session, restoring the defaults
+func (sm *SessionManager) ResetRateLimit(userID string) (worker.RateLimitStatus, error) {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("worker pool not available")
+	}
+
+	if err := sm.sqlStore.DeleteSessionSetting(userID, rateLimitSettingKey); err != nil {
+		return worker.RateLimitStatus{}, fmt.Errorf("falha ao remover limites: %w", err)
+	}
+
+	if err := workerPool.SetRateLimitConfig(userID, worker.DefaultRateLimitConfig()); err != nil {
+		return worker.RateLimitStatus{}, err
+	}
+
+	return workerPool.GetRateLimiter(userID).Status(), nil
+}
+
+// loadRateLimits applies every persisted rate limit config to the worker pool
+func (sm *SessionManager) loadRateLimits() {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return
+	}
+
+	settings, err := sm.sqlStore.GetSessionSettingsByKey(rateLimitSettingKey)
+	if err != nil {
+		logger.Error("Falha ao carregar limites de envio", "error", err)
+		return
+	}
+
+	for userID, value := range settings {
+		var config worker.RateLimitConfig
+		if err := json.Unmarshal([]byte(value), &config); err != nil {
+			logger.Warn("Limite de envio inválido ignorado", "user_id", userID, "error", err)
+			continue
+		}
+		if err := workerPool.SetRateLimitConfig(userID, config); err != nil {
+			logger.Warn("Limite de envio inválido ignorado", "user_id", userID, "error", err)
+		}
+	}
+
+	logger.Info("Limites de envio carregados", "sessions", len(settings))
+}
+
+// paceDirectSend applies the session rate limiter to sends that bypass the worker
+// (e.g. the RabbitMQ consumer), blocking until the reserved slot
+func (sm *SessionManager) paceDirectSend(userID, to string) error {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return nil
+	}
+
+	wait, err := workerPool.GetRateLimiter(userID).Reserve(to)
+	if err != nil {
+		return err
+	}
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+	return nil
+}
+
+// chargeDirectSend charges the session rate limiter for extra messages sent by a direct batch send
+// (e.g. the media of an album)
+func (sm *SessionManager) chargeDirectSend(userID string, count int) {
+	workerPool := sm.coordinator.GetWorkerPool()
+	if workerPool == nil {
+		return
+	}
+
+	workerPool.GetRateLimiter(userID).Charge(count)
+}
@@ -4,6 +4,7 @@ package session
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 	"yourproject/pkg/logger"
 
@@ -28,6 +29,7 @@ func (sm *SessionManager) ProcessEvent(userID string, evt interface{}) {
 	// Determine event type
 	var eventType string
 	var eventData map[string]interface{}
+	var statusData map[string]interface{}
 
 	// Atualizar última atividade do cliente
 	sm.clientsMutex.Lock()
@@ -41,6 +43,13 @@ func (sm *SessionManager) ProcessEvent(userID string, evt interface{}) {
 		eventType = "message"
 		eventData = sm.extractMessageData(typedEvt)
 
+		// Status updates posted by contacts arrive as messages to status@broadcast;
+		// they keep the "message" event and are also published as "status.posted"
+		if typedEvt.Info.Chat == types.StatusBroadcastJID && !typedEvt.Info.IsFromMe && typedEvt.Message.GetProtocolMessage() == nil {
+			statusData = sm.extractMessageData(typedEvt)
+			sm.addStatusData(statusData, typedEvt)
+		}
+
 	case *events.Connected:
 		eventType = "connection.update"
 		eventData = map[string]interface{}{
@@ -108,6 +117,8 @@ func (sm *SessionManager) ProcessEvent(userID string, evt interface{}) {
 		eventType, eventData = sm.handleGroupInfoEvent(userID, typedEvt)
 
 	case *events.JoinedGroup:
+		sm.saveCachedGroup(userID, &typedEvt.GroupInfo)
+
 		eventType = "group.members.updated"
 		memberCount := len(typedEvt.GroupInfo.Participants)
 		participants := sm.extractParticipantDetails(typedEvt.GroupInfo.Participants)
@@ -120,6 +131,192 @@ func (sm *SessionManager) ProcessEvent(userID string, evt interface{}) {
 			"participants": participants,
 		}
 
+	case *events.NewsletterLiveUpdate:
+		eventType = "newsletter.post.updated"
+		eventData = map[string]interface{}{
+			"newsletter_jid": typedEvt.JID.String(),
+			"timestamp":      typedEvt.Time.Unix(),
+			"posts":          sm.extractNewsletterPostCounts(typedEvt.Messages),
+		}
+
+	case *events.Picture:
+		eventType = "contact.picture.updated"
+		eventData = map[string]interface{}{
+			"jid":        typedEvt.JID.String(),
+			"author":     typedEvt.Author.String(),
+			"removed":    typedEvt.Remove,
+			"picture_id": typedEvt.PictureID,
+			"timestamp":  typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.UserAbout:
+		eventType = "contact.about.updated"
+		eventData = map[string]interface{}{
+			"jid":       typedEvt.JID.String(),
+			"about":     typedEvt.Status,
+			"timestamp": typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.PrivacySettings:
+		eventType = "privacy.updated"
+		eventData = map[string]interface{}{
+			"settings":  sm.extractPrivacySettings(typedEvt.NewSettings),
+			"changed":   sm.extractChangedPrivacySettings(typedEvt),
+			"timestamp": time.Now().Unix(),
+		}
+
+	case *events.Blocklist:
+		eventType = "blocklist.updated"
+		changes := make([]map[string]interface{}, len(typedEvt.Changes))
+		for i, change := range typedEvt.Changes {
+			changes[i] = map[string]interface{}{
+				"jid":    change.JID.String(),
+				"action": string(change.Action),
+			}
+		}
+		eventData = map[string]interface{}{
+			"action":    string(typedEvt.Action),
+			"changes":   changes,
+			"timestamp": time.Now().Unix(),
+		}
+
+	case *events.HistorySync:
+		eventType = "history.sync.progress"
+		eventData = map[string]interface{}{
+			"sync_type":     typedEvt.Data.GetSyncType().String(),
+			"progress":      typedEvt.Data.GetProgress(),
+			"chunk_order":   typedEvt.Data.GetChunkOrder(),
+			"conversations": len(typedEvt.Data.GetConversations()),
+			"contacts":      len(typedEvt.Data.GetPushnames()),
+			"timestamp":     time.Now().Unix(),
+		}
+
+	case *events.Archive:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "archive", typedEvt.Timestamp, typedEvt.FromFullSync)
+		eventData["archived"] = typedEvt.Action.GetArchived()
+
+	case *events.Pin:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "pin", typedEvt.Timestamp, typedEvt.FromFullSync)
+		eventData["pinned"] = typedEvt.Action.GetPinned()
+
+	case *events.Mute:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "mute", typedEvt.Timestamp, typedEvt.FromFullSync)
+		eventData["muted"] = typedEvt.Action.GetMuted()
+		if typedEvt.Action.GetMuteEndTimestamp() > 0 {
+			eventData["muted_until"] = time.UnixMilli(typedEvt.Action.GetMuteEndTimestamp()).Unix()
+		}
+
+	case *events.MarkChatAsRead:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "mark_read", typedEvt.Timestamp, typedEvt.FromFullSync)
+		eventData["read"] = typedEvt.Action.GetRead()
+
+	case *events.ClearChat:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "clear", typedEvt.Timestamp, typedEvt.FromFullSync)
+
+	case *events.DeleteChat:
+		eventType = "chat.updated"
+		eventData = sm.extractChatStateData(typedEvt.JID, "delete", typedEvt.Timestamp, typedEvt.FromFullSync)
+
+	case *events.Contact:
+		eventType = "contact.updated"
+		eventData = map[string]interface{}{
+			"jid":            typedEvt.JID.String(),
+			"full_name":      typedEvt.Action.GetFullName(),
+			"first_name":     typedEvt.Action.GetFirstName(),
+			"lid":            typedEvt.Action.GetLidJID(),
+			"from_full_sync": typedEvt.FromFullSync,
+			"timestamp":      typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.PushName:
+		eventType = "contact.push_name.updated"
+		eventData = map[string]interface{}{
+			"jid":       typedEvt.JID.String(),
+			"old_name":  typedEvt.OldPushName,
+			"new_name":  typedEvt.NewPushName,
+			"timestamp": time.Now().Unix(),
+		}
+		if typedEvt.Message != nil {
+			eventData["sender_alt"] = typedEvt.Message.SenderAlt.String()
+		}
+
+	case *events.BusinessName:
+		eventType = "contact.business_name.updated"
+		eventData = map[string]interface{}{
+			"jid":       typedEvt.JID.String(),
+			"old_name":  typedEvt.OldBusinessName,
+			"new_name":  typedEvt.NewBusinessName,
+			"timestamp": time.Now().Unix(),
+		}
+
+	case *events.LabelEdit:
+		eventType = "label.updated"
+		if typedEvt.Action.GetDeleted() {
+			eventType = "label.deleted"
+		}
+		eventData = map[string]interface{}{
+			"label_id":       typedEvt.LabelID,
+			"name":           typedEvt.Action.GetName(),
+			"color":          typedEvt.Action.GetColor(),
+			"predefined_id":  typedEvt.Action.GetPredefinedID(),
+			"deleted":        typedEvt.Action.GetDeleted(),
+			"from_full_sync": typedEvt.FromFullSync,
+			"timestamp":      typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.LabelAssociationChat:
+		eventType = "label.chat.updated"
+		eventData = map[string]interface{}{
+			"label_id":       typedEvt.LabelID,
+			"chat_jid":       typedEvt.JID.String(),
+			"labeled":        typedEvt.Action.GetLabeled(),
+			"from_full_sync": typedEvt.FromFullSync,
+			"timestamp":      typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.LabelAssociationMessage:
+		eventType = "label.message.updated"
+		eventData = map[string]interface{}{
+			"label_id":       typedEvt.LabelID,
+			"chat_jid":       typedEvt.JID.String(),
+			"message_id":     typedEvt.MessageID,
+			"labeled":        typedEvt.Action.GetLabeled(),
+			"from_full_sync": typedEvt.FromFullSync,
+			"timestamp":      typedEvt.Timestamp.Unix(),
+		}
+
+	case *events.CallOffer:
+		eventType = "call.offer"
+		eventData = sm.extractCallData(typedEvt.BasicCallMeta)
+		eventData["remote_platform"] = typedEvt.RemotePlatform
+		eventData["remote_version"] = typedEvt.RemoteVersion
+
+	case *events.CallOfferNotice:
+		eventType = "call.offer"
+		eventData = sm.extractCallData(typedEvt.BasicCallMeta)
+		eventData["media"] = typedEvt.Media
+		eventData["call_type"] = typedEvt.Type
+
+	case *events.CallAccept:
+		eventType = "call.accepted"
+		eventData = sm.extractCallData(typedEvt.BasicCallMeta)
+		eventData["remote_platform"] = typedEvt.RemotePlatform
+		eventData["remote_version"] = typedEvt.RemoteVersion
+
+	case *events.CallReject:
+		eventType = "call.rejected"
+		eventData = sm.extractCallData(typedEvt.BasicCallMeta)
+
+	case *events.CallTerminate:
+		eventType = "call.terminated"
+		eventData = sm.extractCallData(typedEvt.BasicCallMeta)
+		eventData["reason"] = typedEvt.Reason
+
 	case *events.QR:
 		eventType = "qr"
 		eventData = map[string]interface{}{
@@ -138,6 +335,22 @@ func (sm *SessionManager) ProcessEvent(userID string, evt interface{}) {
 			"event_type", fmt.Sprintf("%T", evt))
 	}
 
+	sm.dispatchEvent(userID, eventType, eventData, evt)
+
+	if statusData != nil {
+		sm.dispatchEvent(userID, "status.posted", statusData, evt)
+	}
+}
+
+// EmitEvent publishes an event raised outside of whatsmeow (e.g. an automatic call rejection)
+// the same way as whatsmeow events: to RabbitMQ and to the handlers registered for its type,
+// which receive eventData as the event
+func (sm *SessionManager) EmitEvent(userID, eventType string, eventData map[string]interface{}) {
+	sm.dispatchEvent(userID, eventType, eventData, eventData)
+}
+
+// dispatchEvent publishes the event to RabbitMQ and calls the handlers registered for its type
+func (sm *SessionManager) dispatchEvent(userID, eventType string, eventData map[string]interface{}, evt interface{}) {
 	// Add common metadata
 	eventData["user_id"] = userID
 	eventData["event_type"] = eventType
@@ -192,6 +405,82 @@ func (sm *SessionManager) extractParticipantDetails(participants []types.GroupPa
 	return result
 }
 
+// extractNewsletterPostCounts extracts the view and reaction counts of newsletter posts
+func (sm *SessionManager) extractNewsletterPostCounts(messages []*types.NewsletterMessage) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(messages))
+	for i, msg := range messages {
+		totalReactions := 0
+		for _, count := range msg.ReactionCounts {
+			totalReactions += count
+		}
+
+		result[i] = map[string]interface{}{
+			"message_id":      msg.MessageID,
+			"server_id":       int(msg.MessageServerID),
+			"views_count":     msg.ViewsCount,
+			"reaction_counts": msg.ReactionCounts,
+			"total_reactions": totalReactions,
+		}
+	}
+	return result
+}
+
+// extractPrivacySettings extracts the privacy settings using the same field names as the API
+func (sm *SessionManager) extractPrivacySettings(settings types.PrivacySettings) map[string]interface{} {
+	return map[string]interface{}{
+		"last_seen":     string(settings.LastSeen),
+		"online":        string(settings.Online),
+		"profile_photo": string(settings.Profile),
+		"about":         string(settings.Status),
+		"group_add":     string(settings.GroupAdd),
+		"read_receipts": string(settings.ReadReceipts),
+		"call_add":      string(settings.CallAdd),
+	}
+}
+
+// extractChangedPrivacySettings lists the privacy settings changed by the event
+func (sm *SessionManager) extractChangedPrivacySettings(evt *events.PrivacySettings) []string {
+	changed := make([]string, 0)
+	flags := []struct {
+		name    string
+		changed bool
+	}{
+		{"last_seen", evt.LastSeenChanged},
+		{"online", evt.OnlineChanged},
+		{"profile_photo", evt.ProfileChanged},
+		{"about", evt.StatusChanged},
+		{"group_add", evt.GroupAddChanged},
+		{"read_receipts", evt.ReadReceiptsChanged},
+		{"call_add", evt.CallAddChanged},
+	}
+	for _, flag := range flags {
+		if flag.changed {
+			changed = append(changed, flag.name)
+		}
+	}
+	return changed
+}
+
+// extractChatStateData extracts the fields shared by all chat state events
+func (sm *SessionManager) extractChatStateData(chat types.JID, action string, timestamp time.Time, fromFullSync bool) map[string]interface{} {
+	return map[string]interface{}{
+		"chat":           chat.String(),
+		"action":         action,
+		"from_full_sync": fromFullSync,
+		"timestamp":      timestamp.Unix(),
+	}
+}
+
+// extractCallData extracts the fields shared by all call events
+func (sm *SessionManager) extractCallData(meta types.BasicCallMeta) map[string]interface{} {
+	return map[string]interface{}{
+		"call_id":      meta.CallID,
+		"from":         meta.From.String(),
+		"call_creator": meta.CallCreator.String(),
+		"timestamp":    meta.Timestamp.Unix(),
+	}
+}
+
 // extractMessageData extracts all available data from a message event
 func (sm *SessionManager) extractMessageData(msg *events.Message) map[string]interface{} {
 	data := map[string]interface{}{
@@ -243,6 +532,25 @@ func (sm *SessionManager) extractMessageData(msg *events.Message) map[string]int
 	return data
 }
 
+// addStatusData adds the kind of status and the style of text statuses to the message data
+func (sm *SessionManager) addStatusData(data map[string]interface{}, msg *events.Message) {
+	switch {
+	case msg.Message.GetImageMessage() != nil:
+		data["status_type"] = "image"
+	case msg.Message.GetVideoMessage() != nil:
+		data["status_type"] = "video"
+	case msg.Message.GetAudioMessage() != nil:
+		data["status_type"] = "audio"
+	default:
+		data["status_type"] = "text"
+	}
+
+	if text := msg.Message.GetExtendedTextMessage(); text != nil {
+		data["background_color"] = fmt.Sprintf("#%08X", text.GetBackgroundArgb())
+		data["font"] = strings.ToLower(text.GetFont().String())
+	}
+}
+
 // handleGroupInfoEvent processes GroupInfo events and detects specific actions
 func (sm *SessionManager) handleGroupInfoEvent(userID string, group *events.GroupInfo) (string, map[string]interface{}) {
 	eventType := "group.updated"
@@ -262,19 +570,17 @@ func (sm *SessionManager) handleGroupInfoEvent(userID string, group *events.Grou
 		data["sender_phone"] = group.SenderPN.String()
 	}
 
-	// Get current group info to extract member count
-	if client, exists := sm.GetSession(userID); exists && client.WAClient != nil {
-		if groupInfo, err := client.WAClient.GetGroupInfo(group.JID); err == nil {
-			data["member_count"] = len(groupInfo.Participants)
-			data["group_name"] = groupInfo.Name
-			data["group_topic"] = groupInfo.Topic
-			data["member_add_mode"] = groupInfo.MemberAddMode
-		} else {
-			logger.Warn("Failed to get group info for event",
-				"group_jid", group.JID.String(),
-				"user_id", userID,
-				"error", err)
-		}
+	// Compare the cached state with the current group info and refresh the cache
+	before := sm.cachedGroupInfo(userID, group.JID)
+	if before != nil {
+		data["before"] = sm.groupSnapshot(before)
+	}
+	if groupInfo := sm.refreshCachedGroup(userID, group); groupInfo != nil {
+		data["member_count"] = len(groupInfo.Participants)
+		data["group_name"] = groupInfo.Name
+		data["group_topic"] = groupInfo.Topic
+		data["member_add_mode"] = groupInfo.MemberAddMode
+		data["after"] = sm.groupSnapshot(groupInfo)
 	}
 
 	// Check for specific actions based on what changed
@@ -397,6 +703,41 @@ func (sm *SessionManager) handleGroupInfoEvent(userID string, group *events.Grou
 		data["new_invite_link"] = *group.NewInviteLink
 	}
 
+	// Check for join requests (groups that require admin approval)
+	for _, change := range group.UnknownChanges {
+		switch change.Tag {
+		case "created_membership_requests":
+			eventType = "group.join_request"
+			data["action"] = "join_requested"
+		case "revoked_membership_requests":
+			eventType = "group.join_request.revoked"
+			data["action"] = "join_request_revoked"
+		default:
+			continue
+		}
+
+		if method := change.AttrGetter().OptionalString("request_method"); method != "" {
+			data["request_method"] = method
+		}
+		if group.Sender != nil {
+			data["requester"] = group.Sender.String()
+		}
+		if group.SenderPN != nil {
+			data["requester_phone"] = group.SenderPN.String()
+		}
+
+		// Some notifications list the affected users as child nodes
+		var participants []string
+		for _, child := range change.GetChildren() {
+			if jid, ok := child.Attrs["jid"].(types.JID); ok {
+				participants = append(participants, jid.String())
+			}
+		}
+		if len(participants) > 0 {
+			data["participants"] = participants
+		}
+	}
+
 	// Add version tracking information
 	if group.PrevParticipantVersionID != "" {
 		data["prev_participant_version"] = group.PrevParticipantVersionID
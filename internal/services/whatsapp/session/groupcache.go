@@ -0,0 +1,96 @@
+// internal/services/whatsapp/session/groupcache.go
+package session
+
+import (
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/pkg/logger"
+)
+
+// cachedGroupInfo retorna o último estado conhecido do grupo, ou nil se não estiver em cache
+func (sm *SessionManager) cachedGroupInfo(userID string, groupJID types.JID) *types.GroupInfo {
+	cached, err := sm.sqlStore.GetCachedGroup(userID, groupJID.String())
+	if err != nil {
+		logger.Warn("Falha ao consultar cache de grupos", "user_id", userID, "group_jid", groupJID.String(), "error", err)
+		return nil
+	}
+	if cached == nil {
+		return nil
+	}
+	return &cached.Info
+}
+
+// refreshCachedGroup consulta o estado atual do grupo e atualiza o cache.
+// Se a sessão saiu do grupo ou ele foi apagado, o grupo é removido do cache.
+func (sm *SessionManager) refreshCachedGroup(userID string, group *events.GroupInfo) *types.GroupInfo {
+	client, exists := sm.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return nil
+	}
+
+	if group.Delete != nil || sm.leftGroup(client, group.Leave) {
+		if err := sm.sqlStore.DeleteCachedGroup(userID, group.JID.String()); err != nil {
+			logger.Warn("Falha ao remover grupo do cache", "user_id", userID, "group_jid", group.JID.String(), "error", err)
+		}
+		return nil
+	}
+
+	groupInfo, err := client.WAClient.GetGroupInfo(group.JID)
+	if err != nil {
+		logger.Warn("Failed to get group info for event",
+			"group_jid", group.JID.String(),
+			"user_id", userID,
+			"error", err)
+		return nil
+	}
+
+	sm.saveCachedGroup(userID, groupInfo)
+	return groupInfo
+}
+
+// saveCachedGroup guarda o estado atual do grupo no cache
+func (sm *SessionManager) saveCachedGroup(userID string, groupInfo *types.GroupInfo) {
+	if err := sm.sqlStore.SaveCachedGroup(userID, groupInfo); err != nil {
+		logger.Warn("Falha ao salvar grupo em cache", "user_id", userID, "group_jid", groupInfo.JID.String(), "error", err)
+	}
+}
+
+// leftGroup verifica se a própria sessão está entre os participantes que saíram
+func (sm *SessionManager) leftGroup(client *Client, left []types.JID) bool {
+	store := client.WAClient.Store
+	if store == nil || store.ID == nil {
+		return false
+	}
+
+	for _, jid := range left {
+		if jid.User == store.ID.User || (!store.LID.IsEmpty() && jid.User == store.LID.User) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupSnapshot resume o estado do grupo para comparar antes e depois de uma alteração
+func (sm *SessionManager) groupSnapshot(groupInfo *types.GroupInfo) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"name":                      groupInfo.Name,
+		"topic":                     groupInfo.Topic,
+		"is_locked":                 groupInfo.IsLocked,
+		"is_announce":               groupInfo.IsAnnounce,
+		"is_ephemeral":              groupInfo.IsEphemeral,
+		"disappearing_timer":        groupInfo.DisappearingTimer,
+		"is_join_approval_required": groupInfo.IsJoinApprovalRequired,
+		"member_add_mode":           groupInfo.MemberAddMode,
+		"is_community":              groupInfo.IsParent,
+		"is_default_sub_group":      groupInfo.IsDefaultSubGroup,
+		"member_count":              len(groupInfo.Participants),
+		"participants":              sm.extractParticipantDetails(groupInfo.Participants),
+	}
+
+	if !groupInfo.LinkedParentJID.IsEmpty() {
+		snapshot["community_jid"] = groupInfo.LinkedParentJID.String()
+	}
+
+	return snapshot
+}
@@ -188,12 +188,18 @@ func (sm *SessionManager) CreateSession(ctx context.Context, userID string) (*Cl
 		sm.ProcessEvent(userID, evt)
 	})
 
+	// Sessões restauradas mantêm a data original do pareamento (usada no aquecimento do limitador)
+	createdAt := time.Now()
+	if mapping, err := sm.sqlStore.GetUserDeviceMapping(userID); err == nil && !mapping.CreatedAt.IsZero() {
+		createdAt = mapping.CreatedAt
+	}
+
 	// Store client
 	newClient := &Client{
 		ID:         userID,
 		WAClient:   client,
 		Connected:  false,
-		CreatedAt:  time.Now(),
+		CreatedAt:  createdAt,
 		LastActive: time.Now(),
 	}
 
@@ -518,6 +524,11 @@ func (sm *SessionManager) handleDeviceLogout(userID string) error {
 		logger.Warn("Falha ao remover mapeamento de dispositivo", "user_id", userID, "error", err)
 	}
 
+	// Os grupos em cache pertencem à conta desconectada
+	if err := sm.sqlStore.ClearCachedGroups(userID); err != nil {
+		logger.Warn("Falha ao limpar cache de grupos", "user_id", userID, "error", err)
+	}
+
 	// Obter container do banco de dados
 	container := sm.sqlStore.GetDBContainer()
 	if container == nil {
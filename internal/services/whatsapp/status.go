@@ -0,0 +1,144 @@
+// internal/services/whatsapp/status.go
+package whatsapp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"yourproject/pkg/logger"
+)
+
+// statusSettingKey is the session_settings key holding the status config
+const statusSettingKey = "status"
+
+// StatusConfig defines whether the media of statuses posted by contacts is downloaded and published
+type StatusConfig struct {
+	DownloadMedia bool  `json:"download_media"`
+	MaxMediaBytes int64 `json:"max_media_bytes"`
+}
+
+// DefaultStatusConfig returns the status config applied to sessions without a custom one
+func DefaultStatusConfig() StatusConfig {
+	return StatusConfig{
+		DownloadMedia: false,
+		MaxMediaBytes: 16 * 1024 * 1024,
+	}
+}
+
+// GetStatusConfig returns the status config of a session
+func (sm *SessionManager) GetStatusConfig(userID string) (StatusConfig, error) {
+	value, exists, err := sm.sqlStore.GetSessionSetting(userID, statusSettingKey)
+	if err != nil {
+		return StatusConfig{}, err
+	}
+	if !exists {
+		return DefaultStatusConfig(), nil
+	}
+
+	config := DefaultStatusConfig()
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return StatusConfig{}, fmt.Errorf("configuração de status inválida: %w", err)
+	}
+
+	return config, nil
+}
+
+// SetStatusConfig validates and persists the status config of a session
+func (sm *SessionManager) SetStatusConfig(userID string, config StatusConfig) (StatusConfig, error) {
+	if config.MaxMediaBytes <= 0 {
+		return StatusConfig{}, fmt.Errorf("max_media_bytes deve ser maior que zero")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return StatusConfig{}, fmt.Errorf("falha ao serializar configuração de status: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, statusSettingKey, string(data)); err != nil {
+		return StatusConfig{}, fmt.Errorf("falha ao salvar configuração de status: %w", err)
+	}
+
+	logger.Info("Configuração de status atualizada",
+		"user_id", userID,
+		"download_media", config.DownloadMedia,
+		"max_media_bytes", config.MaxMediaBytes)
+
+	return config, nil
+}
+
+// handleStatusPosted downloads the media of a status posted by a contact when the session opted in
+func (sm *SessionManager) handleStatusPosted(userID string, evt interface{}) error {
+	msg, ok := evt.(*events.Message)
+	if !ok || msg.Message == nil {
+		return nil
+	}
+
+	var media whatsmeow.DownloadableMessage
+	var mimetype string
+	var size uint64
+	switch {
+	case msg.Message.GetImageMessage() != nil:
+		image := msg.Message.GetImageMessage()
+		media, mimetype, size = image, image.GetMimetype(), image.GetFileLength()
+	case msg.Message.GetVideoMessage() != nil:
+		video := msg.Message.GetVideoMessage()
+		media, mimetype, size = video, video.GetMimetype(), video.GetFileLength()
+	default:
+		return nil
+	}
+
+	config, err := sm.GetStatusConfig(userID)
+	if err != nil {
+		return err
+	}
+	if !config.DownloadMedia {
+		return nil
+	}
+	if size > uint64(config.MaxMediaBytes) {
+		logger.Debug("Mídia de status ignorada por exceder o limite",
+			"user_id", userID,
+			"message_id", msg.Info.ID,
+			"size", size,
+			"max_media_bytes", config.MaxMediaBytes)
+		return nil
+	}
+
+	client, exists := sm.sessionManager.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return nil
+	}
+
+	// Downloads can take a while, so they run outside the event loop
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		data, err := client.WAClient.Download(ctx, media)
+		if err != nil {
+			logger.Error("Falha ao baixar mídia de status", "user_id", userID, "message_id", msg.Info.ID, "error", err)
+			return
+		}
+
+		sm.publishStatusMediaEvent(userID, msg, mimetype, data)
+	}()
+
+	return nil
+}
+
+// publishStatusMediaEvent publishes the downloaded media of a status to RabbitMQ and the registered event handlers
+func (sm *SessionManager) publishStatusMediaEvent(userID string, msg *events.Message, mimetype string, media []byte) {
+	sm.sessionManager.EmitEvent(userID, "status.media", map[string]interface{}{
+		"message_id": msg.Info.ID,
+		"from":       msg.Info.Sender.String(),
+		"mimetype":   mimetype,
+		"size":       len(media),
+		"data":       base64.StdEncoding.EncodeToString(media),
+		"timestamp":  msg.Info.Timestamp.Unix(),
+	})
+}
@@ -0,0 +1,445 @@
+// internal/services/whatsapp/suppression.go
+package whatsapp
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"yourproject/internal/services/whatsapp/phone"
+	"yourproject/internal/storage"
+	"yourproject/pkg/logger"
+)
+
+// optOutSettingKey is the session_settings key holding the opt-out config
+const optOutSettingKey = "opt_out"
+
+// Sources of a suppression entry
+const (
+	SuppressionSourceKeyword = "keyword"
+	SuppressionSourceAPI     = "api"
+	SuppressionSourceImport  = "import"
+)
+
+// OptOutConfig defines how inbound opt-out requests are detected and answered
+type OptOutConfig struct {
+	Enabled      bool     `json:"enabled"`
+	Keywords     []string `json:"keywords"`
+	AutoReply    bool     `json:"auto_reply"`
+	ReplyMessage string   `json:"reply_message"`
+}
+
+// DefaultOptOutConfig returns the opt-out config applied to sessions without a custom one
+func DefaultOptOutConfig() OptOutConfig {
+	return OptOutConfig{
+		Enabled:      true,
+		Keywords:     []string{"SAIR", "STOP", "PARAR", "CANCELAR", "DESCADASTRAR"},
+		AutoReply:    false,
+		ReplyMessage: "Pronto! Você não receberá mais mensagens deste número.",
+	}
+}
+
+// SuppressionImportResult summarizes a bulk import into the suppression list
+type SuppressionImportResult struct {
+	Received          int      `json:"received"`
+	Added             int      `json:"added"`
+	AlreadySuppressed int      `json:"already_suppressed"`
+	Invalid           []string `json:"invalid,omitempty"`
+}
+
+// GetOptOutConfig returns the opt-out config of a session
+func (sm *SessionManager) GetOptOutConfig(userID string) (OptOutConfig, error) {
+	value, exists, err := sm.sqlStore.GetSessionSetting(userID, optOutSettingKey)
+	if err != nil {
+		return OptOutConfig{}, err
+	}
+	if !exists {
+		return DefaultOptOutConfig(), nil
+	}
+
+	config := DefaultOptOutConfig()
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return OptOutConfig{}, fmt.Errorf("configuração de opt-out inválida: %w", err)
+	}
+
+	return config, nil
+}
+
+// SetOptOutConfig validates and persists the opt-out config of a session
+func (sm *SessionManager) SetOptOutConfig(userID string, config OptOutConfig) (OptOutConfig, error) {
+	keywords := make([]string, 0, len(config.Keywords))
+	seen := make(map[string]bool, len(config.Keywords))
+	for _, keyword := range config.Keywords {
+		keyword = normalizeOptOutText(keyword)
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		keywords = append(keywords, keyword)
+	}
+	config.Keywords = keywords
+	config.ReplyMessage = strings.TrimSpace(config.ReplyMessage)
+
+	if config.Enabled && len(config.Keywords) == 0 {
+		return OptOutConfig{}, fmt.Errorf("informe ao menos uma palavra-chave de opt-out")
+	}
+	if config.AutoReply && config.ReplyMessage == "" {
+		return OptOutConfig{}, fmt.Errorf("reply_message é obrigatório quando auto_reply está habilitado")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return OptOutConfig{}, fmt.Errorf("falha ao serializar configuração de opt-out: %w", err)
+	}
+
+	if err := sm.sqlStore.SaveSessionSetting(userID, optOutSettingKey, string(data)); err != nil {
+		return OptOutConfig{}, fmt.Errorf("falha ao salvar configuração de opt-out: %w", err)
+	}
+
+	logger.Info("Configuração de opt-out atualizada",
+		"user_id", userID,
+		"enabled", config.Enabled,
+		"keywords", len(config.Keywords),
+		"auto_reply", config.AutoReply)
+
+	return config, nil
+}
+
+// AddSuppression adds a phone number to the suppression list of a session.
+// Returns false if the number was already suppressed.
+func (sm *SessionManager) AddSuppression(userID, number, reason string) (*storage.SuppressionEntry, bool, error) {
+	normalized, err := NormalizeSuppressionPhone(number, sm.DefaultPhoneRegion(userID))
+	if err != nil {
+		return nil, false, err
+	}
+
+	added, err := sm.sqlStore.AddSuppression(storage.SuppressionEntry{
+		UserID: userID,
+		Phone:  normalized,
+		Reason: strings.TrimSpace(reason),
+		Source: SuppressionSourceAPI,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	entry, err := sm.sqlStore.GetSuppression(userID, normalized)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if added {
+		sm.publishSuppressionEvent(userID, "optout.added", entry)
+	}
+
+	return entry, added, nil
+}
+
+// RemoveSuppression removes a phone number from the suppression list of a session.
+// Returns false if the number was not suppressed.
+func (sm *SessionManager) RemoveSuppression(userID, number string) (bool, error) {
+	normalized, err := NormalizeSuppressionPhone(number, sm.DefaultPhoneRegion(userID))
+	if err != nil {
+		return false, err
+	}
+
+	removed, err := sm.sqlStore.RemoveSuppression(userID, normalized)
+	if err != nil {
+		return false, err
+	}
+
+	if removed {
+		sm.publishSuppressionEvent(userID, "optout.removed", &storage.SuppressionEntry{Phone: normalized})
+	}
+
+	return removed, nil
+}
+
+// GetSuppression returns the suppression entry of a phone number, or nil if it is not suppressed
+func (sm *SessionManager) GetSuppression(userID, number string) (*storage.SuppressionEntry, error) {
+	normalized, err := NormalizeSuppressionPhone(number, sm.DefaultPhoneRegion(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.sqlStore.GetSuppression(userID, normalized)
+}
+
+// ListSuppressions returns a page of the suppression list and its total size.
+// A limit of zero or less returns every entry.
+func (sm *SessionManager) ListSuppressions(userID string, limit, offset int) ([]storage.SuppressionEntry, int, error) {
+	entries, err := sm.sqlStore.ListSuppressions(userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := sm.sqlStore.CountSuppressions(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// ImportSuppressions adds several phone numbers to the suppression list of a session
+func (sm *SessionManager) ImportSuppressions(userID string, phones []string, reason string) (*SuppressionImportResult, error) {
+	result := &SuppressionImportResult{Received: len(phones)}
+
+	entries := make([]storage.SuppressionEntry, 0, len(phones))
+	seen := make(map[string]bool, len(phones))
+	region := sm.DefaultPhoneRegion(userID)
+	for _, number := range phones {
+		normalized, err := NormalizeSuppressionPhone(number, region)
+		if err != nil {
+			result.Invalid = append(result.Invalid, number)
+			continue
+		}
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+
+		entries = append(entries, storage.SuppressionEntry{
+			UserID: userID,
+			Phone:  normalized,
+			Reason: strings.TrimSpace(reason),
+			Source: SuppressionSourceImport,
+		})
+	}
+
+	added, err := sm.sqlStore.ImportSuppressions(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Added = added
+	result.AlreadySuppressed = len(entries) - added
+
+	logger.Info("Lista de supressão importada",
+		"user_id", userID,
+		"received", result.Received,
+		"added", result.Added,
+		"invalid", len(result.Invalid))
+
+	return result, nil
+}
+
+// handleOptOutMessage adds the sender of an inbound opt-out keyword to the suppression list
+func (sm *SessionManager) handleOptOutMessage(userID string, evt interface{}) error {
+	msg, ok := evt.(*events.Message)
+	if !ok || msg.Info.IsFromMe || msg.Info.IsGroup || msg.Message == nil {
+		return nil
+	}
+
+	text := msg.Message.GetConversation()
+	if text == "" {
+		text = msg.Message.GetExtendedTextMessage().GetText()
+	}
+	if text == "" {
+		return nil
+	}
+
+	config, err := sm.GetOptOutConfig(userID)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	keyword := matchOptOutKeyword(text, config.Keywords)
+	if keyword == "" {
+		return nil
+	}
+
+	// Prefer the phone number address when the sender is identified by LID
+	sender := msg.Info.Sender
+	if sender.Server != types.DefaultUserServer && msg.Info.SenderAlt.Server == types.DefaultUserServer {
+		sender = msg.Info.SenderAlt
+	}
+	if sender.Server != types.DefaultUserServer {
+		logger.Warn("Opt-out recebido de remetente sem número de telefone", "user_id", userID, "sender", msg.Info.Sender.String())
+		return nil
+	}
+
+	entry := storage.SuppressionEntry{
+		UserID: userID,
+		Phone:  sender.User,
+		Reason: "keyword: " + keyword,
+		Source: SuppressionSourceKeyword,
+	}
+
+	added, err := sm.sqlStore.AddSuppression(entry)
+	if err != nil {
+		return fmt.Errorf("failed to store opt-out: %w", err)
+	}
+	if !added {
+		return nil
+	}
+
+	logger.Info("Opt-out registrado", "user_id", userID, "phone", sender.User, "keyword", keyword)
+	sm.publishSuppressionEvent(userID, "optout.added", &entry)
+
+	if config.AutoReply {
+		go sm.sendOptOutReply(userID, msg.Info.Chat, config.ReplyMessage)
+	}
+
+	return nil
+}
+
+// sendOptOutReply confirms the opt-out to the sender.
+// It talks to the client directly since the recipient is already suppressed.
+func (sm *SessionManager) sendOptOutReply(userID string, chat types.JID, message string) {
+	client, exists := sm.sessionManager.GetSession(userID)
+	if !exists || client.WAClient == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := client.WAClient.SendMessage(ctx, chat, &waE2E.Message{
+		Conversation: proto.String(message),
+	}); err != nil {
+		logger.Error("Falha ao enviar confirmação de opt-out", "user_id", userID, "chat", chat.String(), "error", err)
+	}
+}
+
+// publishSuppressionEvent publishes a suppression list change to RabbitMQ
+func (sm *SessionManager) publishSuppressionEvent(userID, eventType string, entry *storage.SuppressionEntry) {
+	publisher := sm.sessionManager.GetEventPublisher()
+	if publisher == nil || entry == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"user_id":    userID,
+		"event_type": eventType,
+		"phone":      entry.Phone,
+		"timestamp":  time.Now().Unix(),
+	}
+	if entry.Source != "" {
+		data["source"] = entry.Source
+	}
+	if entry.Reason != "" {
+		data["reason"] = entry.Reason
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := publisher.PublishEvent(ctx, userID, eventType, data); err != nil {
+		logger.Error("Failed to publish suppression event", "user_id", userID, "event_type", eventType, "error", err)
+	}
+}
+
+// matchOptOutKeyword returns the keyword matched by the whole message, ignoring case, accents and punctuation
+func matchOptOutKeyword(text string, keywords []string) string {
+	normalized := normalizeOptOutText(text)
+	for _, keyword := range keywords {
+		if normalized == normalizeOptOutText(keyword) {
+			return keyword
+		}
+	}
+	return ""
+}
+
+// normalizeOptOutText uppercases the text, folds common accents and drops surrounding punctuation
+func normalizeOptOutText(text string) string {
+	replacer := strings.NewReplacer(
+		"Á", "A", "À", "A", "Â", "A", "Ã", "A",
+		"É", "E", "Ê", "E",
+		"Í", "I",
+		"Ó", "O", "Ô", "O", "Õ", "O",
+		"Ú", "U", "Ç", "C",
+	)
+
+	text = replacer.Replace(strings.ToUpper(strings.TrimSpace(text)))
+	return strings.TrimFunc(text, func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSpace(r) || unicode.IsSymbol(r)
+	})
+}
+
+// NormalizeSuppressionPhone converts a phone number or user JID into the digits-only form stored in the suppression list.
+// Numbers without a country code are parsed using the given region.
+func NormalizeSuppressionPhone(input, region string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", fmt.Errorf("número não pode ser vazio")
+	}
+
+	if strings.Contains(input, "@") {
+		jid, err := types.ParseJID(input)
+		if err != nil {
+			return "", fmt.Errorf("JID inválido: %w", err)
+		}
+		if jid.Server != types.DefaultUserServer {
+			return "", fmt.Errorf("apenas números de telefone podem ser suprimidos: %s", input)
+		}
+		// JIDs always carry the country code
+		input = "+" + jid.User
+	}
+
+	number, err := phone.Parse(input, region)
+	if err != nil {
+		return "", err
+	}
+
+	return number.Digits(), nil
+}
+
+// ParseSuppressionCSV reads phone numbers from a CSV file.
+// The column named phone, number, to or jid is used, otherwise the first column.
+func ParseSuppressionCSV(r io.Reader) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	column := 0
+	start := 0
+	for i, col := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(col)) {
+		case "phone", "number", "to", "jid":
+			column = i
+			start = 1
+		}
+		if start == 1 {
+			break
+		}
+	}
+
+	// Skip a header row that does not name a known column
+	if start == 0 && len(records[0]) > 0 {
+		if _, err := NormalizeSuppressionPhone(records[0][0], phone.DefaultRegion); err != nil {
+			start = 1
+		}
+	}
+
+	phones := make([]string, 0, len(records)-start)
+	for _, record := range records[start:] {
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+		phones = append(phones, strings.TrimSpace(record[column]))
+	}
+
+	return phones, nil
+}
@@ -0,0 +1,133 @@
+package whatsapp
+
+import (
+	"testing"
+)
+
+func TestMatchOptOutKeyword(t *testing.T) {
+	keywords := DefaultOptOutConfig().Keywords
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Exact keyword",
+			input:    "SAIR",
+			expected: "SAIR",
+		},
+		{
+			name:     "Lowercase keyword",
+			input:    "parar",
+			expected: "PARAR",
+		},
+		{
+			name:     "Keyword with surrounding spaces and punctuation",
+			input:    "  Stop!!  ",
+			expected: "STOP",
+		},
+		{
+			name:     "Keyword with emoji",
+			input:    "cancelar 👍",
+			expected: "CANCELAR",
+		},
+		{
+			name:     "Keyword inside a sentence",
+			input:    "quero sair do grupo",
+			expected: "",
+		},
+		{
+			name:     "Unrelated message",
+			input:    "Bom dia",
+			expected: "",
+		},
+		{
+			name:     "Empty message",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchOptOutKeyword(tt.input, keywords)
+			if result != tt.expected {
+				t.Errorf("For input %q, expected %q, but got %q", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatchOptOutKeywordAccents(t *testing.T) {
+	keywords := []string{"NÃO QUERO", "DESCADASTRAR"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Accented keyword typed with accents",
+			input:    "não quero",
+			expected: "NÃO QUERO",
+		},
+		{
+			name:     "Accented keyword typed without accents",
+			input:    "Nao quero.",
+			expected: "NÃO QUERO",
+		},
+		{
+			name:     "Plain keyword typed with cedilla and accents",
+			input:    "Descadastrár",
+			expected: "DESCADASTRAR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchOptOutKeyword(tt.input, keywords)
+			if result != tt.expected {
+				t.Errorf("For input %q, expected %q, but got %q", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestNormalizeOptOutText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Uppercases the text",
+			input:    "stop",
+			expected: "STOP",
+		},
+		{
+			name:     "Folds accents",
+			input:    "ação",
+			expected: "ACAO",
+		},
+		{
+			name:     "Drops surrounding punctuation and symbols",
+			input:    "¡¡sair!! ✋",
+			expected: "SAIR",
+		},
+		{
+			name:     "Keeps inner spaces",
+			input:    "não quero",
+			expected: "NAO QUERO",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := normalizeOptOutText(tt.input)
+			if result != tt.expected {
+				t.Errorf("For input %q, expected %q, but got %q", tt.input, tt.expected, result)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package worker
 
+import "time"
+
 // Payload structures for different command types
 
 // Message payload structures
@@ -30,10 +32,120 @@ type SendListPayload struct {
 	Sections   []Section `json:"sections"`
 }
 
+type SendAlbumPayload struct {
+	To    string      `json:"to"`
+	Items []AlbumItem `json:"items"`
+}
+
 type CheckNumberPayload struct {
 	Number string `json:"number"`
 }
 
+// Chat payload structures
+type SetChatDisappearingTimerPayload struct {
+	To    string `json:"to"`
+	Timer string `json:"timer"`
+}
+
+type SetDefaultDisappearingTimerPayload struct {
+	Timer string `json:"timer"`
+}
+
+type ArchiveChatPayload struct {
+	Chat    string `json:"chat"`
+	Archive bool   `json:"archive"`
+}
+
+type PinChatPayload struct {
+	Chat string `json:"chat"`
+	Pin  bool   `json:"pin"`
+}
+
+// MuteChatPayload silencia a conversa por Duration; duração zerada silencia para sempre
+type MuteChatPayload struct {
+	Chat     string        `json:"chat"`
+	Mute     bool          `json:"mute"`
+	Duration time.Duration `json:"duration"`
+}
+
+type MarkChatUnreadPayload struct {
+	Chat   string `json:"chat"`
+	Unread bool   `json:"unread"`
+}
+
+type ChatPayload struct {
+	Chat string `json:"chat"`
+}
+
+// Profile payload structures
+type SetProfilePicturePayload struct {
+	ImageURL string `json:"image_url"`
+}
+
+type SetPushNamePayload struct {
+	Name string `json:"name"`
+}
+
+type SetProfileAboutPayload struct {
+	About string `json:"about"`
+}
+
+// PrivacySettingsPayload lista as configurações de privacidade a alterar; campos vazios ficam como estão
+type PrivacySettingsPayload struct {
+	LastSeen     string `json:"last_seen,omitempty"`
+	Online       string `json:"online,omitempty"`
+	ProfilePhoto string `json:"profile_photo,omitempty"`
+	About        string `json:"about,omitempty"`
+	GroupAdd     string `json:"group_add,omitempty"`
+	ReadReceipts string `json:"read_receipts,omitempty"`
+	CallAdd      string `json:"call_add,omitempty"`
+}
+
+type ContactProfilePayload struct {
+	Contact string `json:"contact"`
+	Fresh   bool   `json:"fresh"`
+}
+
+// Blocklist payload structures
+type BlocklistContactPayload struct {
+	Contact string `json:"contact"`
+}
+
+// Label payload structures
+type LabelPayload struct {
+	LabelID string `json:"label_id"`
+	Name    string `json:"name"`
+	Color   int32  `json:"color"`
+}
+
+type LabelChatPayload struct {
+	Chat    string `json:"chat"`
+	LabelID string `json:"label_id"`
+	Labeled bool   `json:"labeled"`
+}
+
+type LabelMessagePayload struct {
+	Chat      string `json:"chat"`
+	MessageID string `json:"message_id"`
+	LabelID   string `json:"label_id"`
+	Labeled   bool   `json:"labeled"`
+}
+
+// Status payload structures
+type TextStatusPayload struct {
+	Text            string   `json:"text"`
+	BackgroundColor string   `json:"background_color"`
+	Font            string   `json:"font"`
+	Audience        []string `json:"audience"`
+}
+
+type MediaStatusPayload struct {
+	MediaURL  string   `json:"media_url"`
+	MediaType string   `json:"media_type"`
+	Caption   string   `json:"caption"`
+	Audience  []string `json:"audience"`
+}
+
 // ButtonData represents a button in a message
 type ButtonData struct {
 	ID          string `json:"id"`
@@ -53,6 +165,13 @@ type Section struct {
 	Rows  []Row  `json:"rows"`
 }
 
+// AlbumItem represents an image or video sent in an album
+type AlbumItem struct {
+	MediaURL  string `json:"media_url"`
+	MediaType string `json:"media_type"`
+	Caption   string `json:"caption"`
+}
+
 // Community payload structures
 type CreateCommunityPayload struct {
 	Name        string `json:"name"`
@@ -105,6 +224,18 @@ type GetCommunityLinkedGroupsPayload struct {
 	CommunityJID string `json:"community_jid"`
 }
 
+type CommunityMembersPayload struct {
+	CommunityJID string `json:"community_jid"`
+}
+
+type SendCommunityAnnouncementPayload struct {
+	CommunityJID string `json:"community_jid"`
+	Message      string `json:"message,omitempty"`
+	MediaURL     string `json:"media_url,omitempty"`
+	MediaType    string `json:"media_type,omitempty"`
+	Caption      string `json:"caption,omitempty"`
+}
+
 // Group payload structures
 type CreateGroupPayload struct {
 	Name         string   `json:"name"`
@@ -113,11 +244,19 @@ type CreateGroupPayload struct {
 
 type GroupInfoPayload struct {
 	GroupJID string `json:"group_jid"`
+	Fresh    bool   `json:"fresh,omitempty"`
+}
+
+type JoinedGroupsPayload struct {
+	Fresh bool `json:"fresh,omitempty"`
 }
 
 type GroupParticipantsPayload struct {
 	GroupJID     string   `json:"group_jid"`
 	Participants []string `json:"participants"`
+	// Apenas para adição: convidar quem não pode ser adicionado por privacidade
+	SendInvite    bool   `json:"send_invite,omitempty"`
+	InviteCaption string `json:"invite_caption,omitempty"`
 }
 
 type UpdateGroupNamePayload struct {
@@ -143,6 +282,10 @@ type JoinGroupWithLinkPayload struct {
 	Link string `json:"link"`
 }
 
+type GroupInviteInfoPayload struct {
+	Link string `json:"link"`
+}
+
 type GroupInviteLinkPayload struct {
 	GroupJID string `json:"group_jid"`
 }
@@ -168,6 +311,22 @@ type SetGroupMemberAddModePayload struct {
 	Mode     string `json:"mode"`
 }
 
+type SetGroupDisappearingTimerPayload struct {
+	GroupJID string `json:"group_jid"`
+	Timer    string `json:"timer"`
+}
+
+// Group join request payload structures
+type GroupJoinRequestsPayload struct {
+	GroupJID string `json:"group_jid"`
+}
+
+type UpdateGroupJoinRequestsPayload struct {
+	GroupJID     string   `json:"group_jid"`
+	Participants []string `json:"participants"`
+	Action       string   `json:"action"`
+}
+
 // Newsletter payload structures
 type CreateChannelPayload struct {
 	Name        string `json:"name"`
@@ -201,3 +360,46 @@ type UpdateNewsletterDescriptionPayload struct {
 	JID         string `json:"jid"`
 	Description string `json:"description"`
 }
+
+// NewsletterPostPayload descreve uma publicação em um canal: texto, imagem, vídeo ou enquete
+type NewsletterPostPayload struct {
+	NewsletterJID   string   `json:"newsletter_jid"`
+	Type            string   `json:"type"`
+	Text            string   `json:"text,omitempty"`
+	MediaURL        string   `json:"media_url,omitempty"`
+	Caption         string   `json:"caption,omitempty"`
+	PollName        string   `json:"poll_name,omitempty"`
+	PollOptions     []string `json:"poll_options,omitempty"`
+	SelectableCount int      `json:"selectable_count,omitempty"`
+}
+
+type EditNewsletterPostPayload struct {
+	NewsletterJID string `json:"newsletter_jid"`
+	MessageID     string `json:"message_id"`
+	Text          string `json:"text"`
+}
+
+type DeleteNewsletterPostPayload struct {
+	NewsletterJID string `json:"newsletter_jid"`
+	MessageID     string `json:"message_id"`
+}
+
+type NewsletterPostsPayload struct {
+	JID    string `json:"jid"`
+	Count  int    `json:"count"`
+	Before int    `json:"before"`
+}
+
+type NewsletterPostUpdatesPayload struct {
+	JID   string    `json:"jid"`
+	Count int       `json:"count"`
+	Since time.Time `json:"since"`
+	After int       `json:"after"`
+}
+
+// NewsletterAdminPayload identifica o usuário alvo de uma operação de administração do canal
+type NewsletterAdminPayload struct {
+	JID     string `json:"jid"`
+	User    string `json:"user"`
+	Caption string `json:"caption,omitempty"`
+}
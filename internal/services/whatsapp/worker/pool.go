@@ -50,6 +50,8 @@ type PoolMetrics struct {
 	FailedTasks     int64
 	AverageTaskTime time.Duration
 	StartTime       time.Time
+	QueuedSends     int64
+	ThrottledSends  int64
 	mu              sync.RWMutex
 }
 
@@ -67,6 +69,8 @@ func (pm *PoolMetrics) GetSnapshot() PoolMetrics {
 		FailedTasks:     pm.FailedTasks,
 		AverageTaskTime: pm.AverageTaskTime,
 		StartTime:       pm.StartTime,
+		QueuedSends:     pm.QueuedSends,
+		ThrottledSends:  pm.ThrottledSends,
 	}
 }
 
@@ -82,6 +86,11 @@ type WorkerPool struct {
 	taskQueues map[TaskPriority]chan Task
 	scheduler  *TaskScheduler
 
+	// Rate limiting per session
+	rateLimiters   map[string]*RateLimiter
+	rateLimitersMu sync.Mutex
+	sessionSince   func(userID string) (time.Time, bool)
+
 	// Dependencies
 	sessionManager SessionManager
 	coordinator    Coordinator
@@ -111,6 +120,7 @@ func NewWorkerPool(sessionMgr SessionManager, coord Coordinator, config *WorkerC
 		config:         config,
 		poolConfig:     DefaultPoolConfig(),
 		taskQueues:     make(map[TaskPriority]chan Task),
+		rateLimiters:   make(map[string]*RateLimiter),
 		sessionManager: sessionMgr,
 		coordinator:    coord,
 		// Extract services from coordinator
@@ -143,6 +153,7 @@ func NewWorkerPoolWithServices(sessionMgr SessionManager, coord Coordinator, com
 		config:            config,
 		poolConfig:        DefaultPoolConfig(),
 		taskQueues:        make(map[TaskPriority]chan Task),
+		rateLimiters:      make(map[string]*RateLimiter),
 		sessionManager:    sessionMgr,
 		coordinator:       coord,
 		communityService:  communityService,
@@ -252,6 +263,7 @@ func (wp *WorkerPool) CreateWorker(userID string, workerType WorkerType) (*Worke
 	workerID := fmt.Sprintf("worker_%s_%d", userID, time.Now().Unix())
 
 	worker := NewWorker(workerID, userID, workerType, wp.sessionManager, wp.coordinator, wp.communityService, wp.groupService, wp.messageService, wp.newsletterService, wp.config)
+	worker.rateLimiter = wp.GetRateLimiter(userID)
 
 	// Iniciar worker
 	if err := worker.Start(); err != nil {
@@ -340,9 +352,67 @@ func (wp *WorkerPool) SendEvent(userID string, evt interface{}) error {
 
 // GetMetrics retorna as métricas do pool
 func (wp *WorkerPool) GetMetrics() PoolMetrics {
+	wp.syncRateLimitMetrics()
 	return wp.metrics.GetSnapshot()
 }
 
+// syncRateLimitMetrics agrega envios enfileirados e bloqueados de todos os limitadores
+func (wp *WorkerPool) syncRateLimitMetrics() {
+	var queued, throttled int64
+
+	wp.rateLimitersMu.Lock()
+	for _, limiter := range wp.rateLimiters {
+		status := limiter.Status()
+		queued += status.Metrics.Queued
+		throttled += status.Metrics.Throttled
+	}
+	wp.rateLimitersMu.Unlock()
+
+	wp.metrics.mu.Lock()
+	wp.metrics.QueuedSends = queued
+	wp.metrics.ThrottledSends = throttled
+	wp.metrics.mu.Unlock()
+}
+
+// GetRateLimiter retorna o limitador de envio da sessão, criando um com os limites padrão se necessário
+func (wp *WorkerPool) GetRateLimiter(userID string) *RateLimiter {
+	wp.rateLimitersMu.Lock()
+	defer wp.rateLimitersMu.Unlock()
+
+	limiter, exists := wp.rateLimiters[userID]
+	if !exists {
+		limiter = NewRateLimiter(DefaultRateLimitConfig())
+		wp.rateLimiters[userID] = limiter
+	}
+
+	// Semear o aquecimento com o início da sessão assim que ela for conhecida,
+	// qualquer que seja o caminho que criou o worker ou o limitador
+	if wp.sessionSince != nil {
+		if since, ok := wp.sessionSince(userID); ok {
+			limiter.seedSessionSince(since)
+		}
+	}
+
+	return limiter
+}
+
+// SetSessionSinceFunc define como obter o início de uma sessão para o aquecimento dos limitadores
+func (wp *WorkerPool) SetSessionSinceFunc(fn func(userID string) (time.Time, bool)) {
+	wp.rateLimitersMu.Lock()
+	defer wp.rateLimitersMu.Unlock()
+	wp.sessionSince = fn
+}
+
+// SetRateLimitConfig aplica uma nova configuração de limite à sessão
+func (wp *WorkerPool) SetRateLimitConfig(userID string, config RateLimitConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	wp.GetRateLimiter(userID).SetConfig(config)
+	return nil
+}
+
 // ListWorkers retorna informações de todos os workers
 func (wp *WorkerPool) ListWorkers() map[string]WorkerInfo {
 	wp.workersMu.RLock()
@@ -0,0 +1,370 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"yourproject/pkg/logger"
+)
+
+// ErrRateLimited é retornado quando um envio excede o limite da sessão
+var ErrRateLimited = errors.New("limite de envio da sessão excedido")
+
+// outboundCommands lista os comandos que enviam mensagens e passam pelo limitador
+var outboundCommands = map[CommandType]bool{
+	CmdSendText:    true,
+	CmdSendMedia:   true,
+	CmdSendButtons: true,
+	CmdSendList:    true,
+	CmdSendAlbum:   true,
+
+	CmdSendCommunityAnnouncement: true,
+	CmdPublishNewsletterPost:     true,
+	CmdPostTextStatus:            true,
+	CmdPostMediaStatus:           true,
+}
+
+// IsOutboundCommand retorna se o comando envia uma mensagem
+func IsOutboundCommand(cmd CommandType) bool {
+	return outboundCommands[cmd]
+}
+
+// RateLimitConfig define os limites de envio de uma sessão
+type RateLimitConfig struct {
+	MessagesPerMinute           int  `json:"messages_per_minute"`
+	Burst                       int  `json:"burst"`
+	PerRecipientIntervalSeconds int  `json:"per_recipient_interval_seconds"`
+	MinDelayMs                  int  `json:"min_delay_ms"`
+	MaxDelayMs                  int  `json:"max_delay_ms"`
+	WarmupEnabled               bool `json:"warmup_enabled"`
+	WarmupDays                  int  `json:"warmup_days"`
+	MaxQueueWaitSeconds         int  `json:"max_queue_wait_seconds"`
+}
+
+// DefaultRateLimitConfig retorna os limites padrão aplicados a toda sessão
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		MessagesPerMinute:           30,
+		Burst:                       5,
+		PerRecipientIntervalSeconds: 3,
+		MinDelayMs:                  300,
+		MaxDelayMs:                  1500,
+		WarmupEnabled:               true,
+		WarmupDays:                  7,
+		MaxQueueWaitSeconds:         25,
+	}
+}
+
+// Validate verifica se a configuração é consistente
+func (c RateLimitConfig) Validate() error {
+	if c.MessagesPerMinute <= 0 {
+		return fmt.Errorf("messages_per_minute deve ser maior que zero")
+	}
+	if c.Burst <= 0 {
+		return fmt.Errorf("burst deve ser maior que zero")
+	}
+	if c.PerRecipientIntervalSeconds < 0 || c.MinDelayMs < 0 || c.MaxDelayMs < 0 {
+		return fmt.Errorf("intervalos e atrasos não podem ser negativos")
+	}
+	if c.MaxDelayMs < c.MinDelayMs {
+		return fmt.Errorf("max_delay_ms deve ser maior ou igual a min_delay_ms")
+	}
+	if c.WarmupEnabled && c.WarmupDays <= 0 {
+		return fmt.Errorf("warmup_days deve ser maior que zero quando o aquecimento está habilitado")
+	}
+	if c.MaxQueueWaitSeconds <= 0 {
+		return fmt.Errorf("max_queue_wait_seconds deve ser maior que zero")
+	}
+	return nil
+}
+
+// RateLimitMetrics contém contadores de envios do limitador
+type RateLimitMetrics struct {
+	Immediate     int64         `json:"immediate"`
+	Queued        int64         `json:"queued"`
+	Throttled     int64         `json:"throttled"`
+	TotalWait     time.Duration `json:"total_wait"`
+	LastThrottled time.Time     `json:"last_throttled,omitempty"`
+}
+
+// RateLimitStatus descreve o estado atual do limitador de uma sessão
+type RateLimitStatus struct {
+	Config                     RateLimitConfig  `json:"config"`
+	EffectiveMessagesPerMinute int              `json:"effective_messages_per_minute"`
+	WarmupFactor               float64          `json:"warmup_factor"`
+	AvailableTokens            float64          `json:"available_tokens"`
+	Metrics                    RateLimitMetrics `json:"metrics"`
+}
+
+// RateLimiter implementa token bucket por sessão com intervalo mínimo por destinatário
+type RateLimiter struct {
+	config        RateLimitConfig
+	sessionSince  time.Time
+	tokens        float64
+	lastRefill    time.Time
+	lastRecipient map[string]time.Time
+	metrics       RateLimitMetrics
+	mu            sync.Mutex
+}
+
+// NewRateLimiter cria um novo limitador com a configuração informada
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		config:        config,
+		tokens:        float64(config.Burst),
+		lastRefill:    time.Now(),
+		lastRecipient: make(map[string]time.Time),
+	}
+}
+
+// SetConfig atualiza a configuração mantendo o estado do bucket
+func (rl *RateLimiter) SetConfig(config RateLimitConfig) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.config = config
+	if rl.tokens > float64(config.Burst) {
+		rl.tokens = float64(config.Burst)
+	}
+}
+
+// SetSessionSince define o início da sessão usado no perfil de aquecimento
+func (rl *RateLimiter) SetSessionSince(since time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.sessionSince = since
+}
+
+// seedSessionSince define o início da sessão apenas se ainda não tiver sido definido
+func (rl *RateLimiter) seedSessionSince(since time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.sessionSince.IsZero() {
+		rl.sessionSince = since
+	}
+}
+
+// Reserve reserva um envio para o destinatário e retorna quanto tempo aguardar.
+// Retorna ErrRateLimited se a espera exceder o máximo permitido.
+func (rl *RateLimiter) Reserve(recipient string) (time.Duration, error) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rate := rl.refill(now)
+
+	var wait time.Duration
+	if rl.tokens < 1 {
+		wait = time.Duration((1 - rl.tokens) / rate)
+	}
+
+	// Intervalo mínimo entre mensagens para o mesmo destinatário
+	if recipient != "" && rl.config.PerRecipientIntervalSeconds > 0 {
+		if last, ok := rl.lastRecipient[recipient]; ok {
+			next := last.Add(time.Duration(rl.config.PerRecipientIntervalSeconds) * time.Second)
+			if recipientWait := next.Sub(now); recipientWait > wait {
+				wait = recipientWait
+			}
+		}
+	}
+
+	// Envios que dependem do bucket ou do intervalo por destinatário são contados como enfileirados
+	queued := wait > 0
+
+	// Atraso aleatório para humanizar o envio
+	wait += randomDelay(rl.config.MinDelayMs, rl.config.MaxDelayMs)
+
+	if wait > time.Duration(rl.config.MaxQueueWaitSeconds)*time.Second {
+		rl.metrics.Throttled++
+		rl.metrics.LastThrottled = now
+		return 0, fmt.Errorf("%w: aguarde %s antes de enviar novamente", ErrRateLimited, wait.Round(time.Second))
+	}
+
+	rl.tokens--
+	if recipient != "" {
+		rl.lastRecipient[recipient] = now.Add(wait)
+		rl.pruneRecipients(now)
+	}
+
+	if queued {
+		rl.metrics.Queued++
+	} else {
+		rl.metrics.Immediate++
+	}
+	rl.metrics.TotalWait += wait
+
+	return wait, nil
+}
+
+// Charge desconta envios já realizados sem aguardar, como as mídias de um álbum.
+// O saldo pode ficar negativo, atrasando os próximos envios da sessão.
+func (rl *RateLimiter) Charge(count int) {
+	if count <= 0 {
+		return
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.refill(time.Now())
+	rl.tokens -= float64(count)
+}
+
+// refill reabastece os tokens desde a última reserva e retorna a taxa por nanossegundo
+func (rl *RateLimiter) refill(now time.Time) float64 {
+	rate := float64(rl.effectivePerMinute(now)) / float64(time.Minute)
+
+	rl.tokens += float64(now.Sub(rl.lastRefill)) * rate
+	if rl.tokens > float64(rl.config.Burst) {
+		rl.tokens = float64(rl.config.Burst)
+	}
+	rl.lastRefill = now
+
+	return rate
+}
+
+// Status retorna a configuração, o limite efetivo e as métricas do limitador
+func (rl *RateLimiter) Status() RateLimitStatus {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	perMinute := rl.effectivePerMinute(now)
+	tokens := rl.tokens + float64(now.Sub(rl.lastRefill))*float64(perMinute)/float64(time.Minute)
+	if tokens > float64(rl.config.Burst) {
+		tokens = float64(rl.config.Burst)
+	}
+
+	return RateLimitStatus{
+		Config:                     rl.config,
+		EffectiveMessagesPerMinute: perMinute,
+		WarmupFactor:               rl.warmupFactor(now),
+		AvailableTokens:            tokens,
+		Metrics:                    rl.metrics,
+	}
+}
+
+// effectivePerMinute aplica o fator de aquecimento ao limite configurado
+func (rl *RateLimiter) effectivePerMinute(now time.Time) int {
+	perMinute := int(float64(rl.config.MessagesPerMinute) * rl.warmupFactor(now))
+	if perMinute < 1 {
+		perMinute = 1
+	}
+	return perMinute
+}
+
+// warmupFactor retorna a fração do limite liberada conforme a idade da sessão.
+// Números recém pareados começam com 25% do limite e chegam a 100% ao fim do período.
+func (rl *RateLimiter) warmupFactor(now time.Time) float64 {
+	if !rl.config.WarmupEnabled || rl.sessionSince.IsZero() || rl.config.WarmupDays <= 0 {
+		return 1
+	}
+
+	period := time.Duration(rl.config.WarmupDays) * 24 * time.Hour
+	age := now.Sub(rl.sessionSince)
+	if age >= period {
+		return 1
+	}
+	if age < 0 {
+		age = 0
+	}
+
+	return 0.25 + 0.75*float64(age)/float64(period)
+}
+
+// pruneRecipients descarta destinatários cujo intervalo mínimo já expirou
+func (rl *RateLimiter) pruneRecipients(now time.Time) {
+	if len(rl.lastRecipient) < 1000 {
+		return
+	}
+
+	interval := time.Duration(rl.config.PerRecipientIntervalSeconds) * time.Second
+	for recipient, last := range rl.lastRecipient {
+		if now.Sub(last) > interval {
+			delete(rl.lastRecipient, recipient)
+		}
+	}
+}
+
+// randomDelay retorna um atraso aleatório entre min e max milissegundos
+func randomDelay(minMs, maxMs int) time.Duration {
+	if maxMs <= 0 {
+		return 0
+	}
+	delay := minMs
+	if maxMs > minMs {
+		delay += rand.Intn(maxMs - minMs + 1)
+	}
+	return time.Duration(delay) * time.Millisecond
+}
+
+// outboundRecipient extrai o destinatário do payload de um comando de envio
+func outboundRecipient(payload interface{}) string {
+	switch p := payload.(type) {
+	case SendTextPayload:
+		return p.To
+	case SendMediaPayload:
+		return p.To
+	case SendButtonsPayload:
+		return p.To
+	case SendListPayload:
+		return p.To
+	case SendAlbumPayload:
+		return p.To
+	case SendCommunityAnnouncementPayload:
+		return p.CommunityJID
+	case NewsletterPostPayload:
+		return p.NewsletterJID
+	case TextStatusPayload, MediaStatusPayload:
+		return "status@broadcast"
+	default:
+		return ""
+	}
+}
+
+// outboundBatch é implementado pelos resultados de comandos que enviam várias mensagens de uma vez
+type outboundBatch interface {
+	OutboundCount() int
+}
+
+// chargeOutbound desconta do limitador as mensagens extras enviadas por um comando em lote
+func (w *Worker) chargeOutbound(response CommandResponse) {
+	if w.rateLimiter == nil || response.Error != nil {
+		return
+	}
+	if batch, ok := response.Data.(outboundBatch); ok {
+		w.rateLimiter.Charge(batch.OutboundCount())
+	}
+}
+
+// paceOutbound aguarda a vez do envio conforme o limitador da sessão
+func (w *Worker) paceOutbound(task Task) error {
+	if w.rateLimiter == nil || !IsOutboundCommand(task.Type) {
+		return nil
+	}
+
+	wait, err := w.rateLimiter.Reserve(outboundRecipient(task.Payload))
+	if err != nil {
+		logger.Warn("Envio bloqueado pelo limitador", "worker_id", w.ID, "user_id", w.UserID, "task_id", task.ID)
+		return err
+	}
+
+	if wait <= 0 {
+		return nil
+	}
+
+	logger.Debug("Envio aguardando limitador", "worker_id", w.ID, "user_id", w.UserID, "wait", wait)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-w.done:
+		return fmt.Errorf("worker %s parado durante a espera do limitador", w.ID)
+	}
+}
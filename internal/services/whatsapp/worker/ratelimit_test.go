@@ -0,0 +1,215 @@
+package worker
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// testRateLimitConfig retorna uma configuração sem atraso aleatório nem aquecimento, para esperas previsíveis
+func testRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		MessagesPerMinute:           60,
+		Burst:                       3,
+		PerRecipientIntervalSeconds: 5,
+		WarmupEnabled:               false,
+		MaxQueueWaitSeconds:         25,
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   func() RateLimitConfig
+		previous []string
+		charge   int
+		to       string
+		minWait  time.Duration
+		maxWait  time.Duration
+		hasError bool
+	}{
+		{
+			name:    "First send is immediate",
+			config:  testRateLimitConfig,
+			to:      "5511988376411",
+			minWait: 0,
+			maxWait: 0,
+		},
+		{
+			name:     "Sends within the burst are immediate",
+			config:   testRateLimitConfig,
+			previous: []string{"5511900000001", "5511900000002"},
+			to:       "5511988376411",
+			minWait:  0,
+			maxWait:  0,
+		},
+		{
+			name:     "Send after the burst waits for the next token",
+			config:   testRateLimitConfig,
+			previous: []string{"5511900000001", "5511900000002", "5511900000003"},
+			to:       "5511988376411",
+			minWait:  900 * time.Millisecond,
+			maxWait:  time.Second,
+		},
+		{
+			name:     "Same recipient waits for the per-recipient interval",
+			config:   testRateLimitConfig,
+			previous: []string{"5511988376411"},
+			to:       "5511988376411",
+			minWait:  4900 * time.Millisecond,
+			maxWait:  5 * time.Second,
+		},
+		{
+			name: "Per-recipient interval disabled",
+			config: func() RateLimitConfig {
+				config := testRateLimitConfig()
+				config.PerRecipientIntervalSeconds = 0
+				return config
+			},
+			previous: []string{"5511988376411"},
+			to:       "5511988376411",
+			minWait:  0,
+			maxWait:  0,
+		},
+		{
+			name:    "Charged batch consumes the burst",
+			config:  testRateLimitConfig,
+			charge:  3,
+			to:      "5511988376411",
+			minWait: 900 * time.Millisecond,
+			maxWait: time.Second,
+		},
+		{
+			name: "Wait above the maximum is rejected",
+			config: func() RateLimitConfig {
+				config := testRateLimitConfig()
+				config.MaxQueueWaitSeconds = 1
+				return config
+			},
+			previous: []string{"5511988376411"},
+			to:       "5511988376411",
+			hasError: true,
+		},
+		{
+			name: "Random delay stays within the configured range",
+			config: func() RateLimitConfig {
+				config := testRateLimitConfig()
+				config.MinDelayMs = 100
+				config.MaxDelayMs = 200
+				return config
+			},
+			to:      "5511988376411",
+			minWait: 100 * time.Millisecond,
+			maxWait: 200 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewRateLimiter(tt.config())
+
+			for _, recipient := range tt.previous {
+				if _, err := limiter.Reserve(recipient); err != nil {
+					t.Fatalf("Reserve(%s) error = %v", recipient, err)
+				}
+			}
+			limiter.Charge(tt.charge)
+
+			wait, err := limiter.Reserve(tt.to)
+			if tt.hasError {
+				if !errors.Is(err, ErrRateLimited) {
+					t.Errorf("For input %s, expected ErrRateLimited, but got %v", tt.to, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("For input %s, expected no error, but got %v", tt.to, err)
+			}
+			if wait < tt.minWait || wait > tt.maxWait {
+				t.Errorf("For input %s, expected wait between %s and %s, but got %s", tt.to, tt.minWait, tt.maxWait, wait)
+			}
+		})
+	}
+}
+
+func TestRateLimiterWarmup(t *testing.T) {
+	const warmupDays = 4
+	period := time.Duration(warmupDays) * 24 * time.Hour
+
+	tests := []struct {
+		name              string
+		warmupEnabled     bool
+		age               time.Duration
+		expectedFactor    float64
+		expectedPerMinute int
+	}{
+		{
+			name:              "Unknown session age has no warm-up",
+			warmupEnabled:     true,
+			expectedFactor:    1,
+			expectedPerMinute: 60,
+		},
+		{
+			name:              "Just paired session starts at 25%",
+			warmupEnabled:     true,
+			age:               time.Second,
+			expectedFactor:    0.25,
+			expectedPerMinute: 15,
+		},
+		{
+			name:              "Halfway through the warm-up",
+			warmupEnabled:     true,
+			age:               period / 2,
+			expectedFactor:    0.625,
+			expectedPerMinute: 37,
+		},
+		{
+			name:              "Warm-up finished",
+			warmupEnabled:     true,
+			age:               period + time.Hour,
+			expectedFactor:    1,
+			expectedPerMinute: 60,
+		},
+		{
+			name:              "Warm-up disabled",
+			warmupEnabled:     false,
+			age:               time.Second,
+			expectedFactor:    1,
+			expectedPerMinute: 60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testRateLimitConfig()
+			config.WarmupEnabled = tt.warmupEnabled
+			config.WarmupDays = warmupDays
+
+			limiter := NewRateLimiter(config)
+			if tt.age > 0 {
+				limiter.SetSessionSince(time.Now().Add(-tt.age))
+			}
+
+			status := limiter.Status()
+			if math.Abs(status.WarmupFactor-tt.expectedFactor) > 0.001 {
+				t.Errorf("For age %s, expected factor %.3f, but got %.3f", tt.age, tt.expectedFactor, status.WarmupFactor)
+			}
+			if status.EffectiveMessagesPerMinute != tt.expectedPerMinute {
+				t.Errorf("For age %s, expected %d messages per minute, but got %d", tt.age, tt.expectedPerMinute, status.EffectiveMessagesPerMinute)
+			}
+		})
+	}
+}
+
+func TestRateLimiterSeedSessionSince(t *testing.T) {
+	limiter := NewRateLimiter(DefaultRateLimitConfig())
+
+	configured := time.Now().Add(-time.Hour)
+	limiter.SetSessionSince(configured)
+	limiter.seedSessionSince(time.Now())
+
+	if !limiter.sessionSince.Equal(configured) {
+		t.Errorf("Expected seedSessionSince to keep %s, but got %s", configured, limiter.sessionSince)
+	}
+}
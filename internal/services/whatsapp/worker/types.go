@@ -102,6 +102,7 @@ const (
 	CmdSendMedia   CommandType = "send_media"
 	CmdSendButtons CommandType = "send_buttons"
 	CmdSendList    CommandType = "send_list"
+	CmdSendAlbum   CommandType = "send_album"
 	CmdCheckNumber CommandType = "check_number"
 
 	// Community commands
@@ -119,26 +120,69 @@ const (
 	CmdRevokeCommunityInviteLink  CommandType = "revoke_community_invite_link"
 	CmdJoinCommunityWithLink      CommandType = "join_community_with_link"
 	CmdGetCommunityLinkedGroups   CommandType = "get_community_linked_groups"
+	CmdGetCommunityMembers        CommandType = "get_community_members"
+	CmdSendCommunityAnnouncement  CommandType = "send_community_announcement"
 
 	// Group commands
-	CmdCreateGroup              CommandType = "create_group"
-	CmdGetGroupInfo             CommandType = "get_group_info"
-	CmdGetJoinedGroups          CommandType = "get_joined_groups"
-	CmdAddGroupParticipants     CommandType = "add_group_participants"
-	CmdRemoveGroupParticipants  CommandType = "remove_group_participants"
-	CmdPromoteGroupParticipants CommandType = "promote_group_participants"
-	CmdDemoteGroupParticipants  CommandType = "demote_group_participants"
-	CmdUpdateGroupName          CommandType = "update_group_name"
-	CmdUpdateGroupTopic         CommandType = "update_group_topic"
-	CmdUpdateGroupPicture       CommandType = "update_group_picture"
-	CmdLeaveGroup               CommandType = "leave_group"
-	CmdJoinGroupWithLink        CommandType = "join_group_with_link"
-	CmdGetGroupInviteLink       CommandType = "get_group_invite_link"
-	CmdRevokeGroupInviteLink    CommandType = "revoke_group_invite_link"
-	CmdSetGroupLocked           CommandType = "set_group_locked"
-	CmdSetGroupAnnounce         CommandType = "set_group_announce"
-	CmdSetGroupJoinApprovalMode CommandType = "set_group_join_approval_mode"
-	CmdSetGroupMemberAddMode    CommandType = "set_group_member_add_mode"
+	CmdCreateGroup               CommandType = "create_group"
+	CmdGetGroupInfo              CommandType = "get_group_info"
+	CmdGetJoinedGroups           CommandType = "get_joined_groups"
+	CmdAddGroupParticipants      CommandType = "add_group_participants"
+	CmdRemoveGroupParticipants   CommandType = "remove_group_participants"
+	CmdPromoteGroupParticipants  CommandType = "promote_group_participants"
+	CmdDemoteGroupParticipants   CommandType = "demote_group_participants"
+	CmdUpdateGroupName           CommandType = "update_group_name"
+	CmdUpdateGroupTopic          CommandType = "update_group_topic"
+	CmdUpdateGroupPicture        CommandType = "update_group_picture"
+	CmdLeaveGroup                CommandType = "leave_group"
+	CmdJoinGroupWithLink         CommandType = "join_group_with_link"
+	CmdGetGroupInviteInfo        CommandType = "get_group_invite_info"
+	CmdGetGroupInviteLink        CommandType = "get_group_invite_link"
+	CmdRevokeGroupInviteLink     CommandType = "revoke_group_invite_link"
+	CmdSetGroupLocked            CommandType = "set_group_locked"
+	CmdSetGroupAnnounce          CommandType = "set_group_announce"
+	CmdSetGroupJoinApprovalMode  CommandType = "set_group_join_approval_mode"
+	CmdSetGroupMemberAddMode     CommandType = "set_group_member_add_mode"
+	CmdGetGroupJoinRequests      CommandType = "get_group_join_requests"
+	CmdUpdateGroupJoinRequests   CommandType = "update_group_join_requests"
+	CmdSetGroupDisappearingTimer CommandType = "set_group_disappearing_timer"
+
+	// Chat commands
+	CmdSetChatDisappearingTimer    CommandType = "set_chat_disappearing_timer"
+	CmdSetDefaultDisappearingTimer CommandType = "set_default_disappearing_timer"
+	CmdArchiveChat                 CommandType = "archive_chat"
+	CmdPinChat                     CommandType = "pin_chat"
+	CmdMuteChat                    CommandType = "mute_chat"
+	CmdMarkChatUnread              CommandType = "mark_chat_unread"
+	CmdClearChat                   CommandType = "clear_chat"
+	CmdDeleteChat                  CommandType = "delete_chat"
+
+	// Profile commands
+	CmdGetOwnProfile     CommandType = "get_own_profile"
+	CmdSetProfilePicture CommandType = "set_profile_picture"
+	CmdSetPushName       CommandType = "set_push_name"
+	CmdSetProfileAbout   CommandType = "set_profile_about"
+	CmdGetContactProfile CommandType = "get_contact_profile"
+
+	// Privacy commands
+	CmdGetPrivacySettings    CommandType = "get_privacy_settings"
+	CmdUpdatePrivacySettings CommandType = "update_privacy_settings"
+
+	// Blocklist commands
+	CmdGetBlocklist   CommandType = "get_blocklist"
+	CmdBlockContact   CommandType = "block_contact"
+	CmdUnblockContact CommandType = "unblock_contact"
+
+	// Label commands
+	CmdCreateLabel  CommandType = "create_label"
+	CmdEditLabel    CommandType = "edit_label"
+	CmdDeleteLabel  CommandType = "delete_label"
+	CmdLabelChat    CommandType = "label_chat"
+	CmdLabelMessage CommandType = "label_message"
+
+	// Status commands
+	CmdPostTextStatus  CommandType = "post_text_status"
+	CmdPostMediaStatus CommandType = "post_media_status"
 
 	// Newsletter commands
 	CmdCreateChannel          CommandType = "create_channel"
@@ -152,6 +196,17 @@ const (
 	CmdUpdateNewsletterPicture CommandType = "update_newsletter_picture"
 	CmdUpdateNewsletterName    CommandType = "update_newsletter_name"
 	CmdUpdateNewsletterDescription CommandType = "update_newsletter_description"
+	CmdPublishNewsletterPost       CommandType = "publish_newsletter_post"
+	CmdEditNewsletterPost          CommandType = "edit_newsletter_post"
+	CmdDeleteNewsletterPost        CommandType = "delete_newsletter_post"
+	CmdGetNewsletterPosts          CommandType = "get_newsletter_posts"
+	CmdGetNewsletterPostUpdates    CommandType = "get_newsletter_post_updates"
+	CmdSubscribeNewsletterUpdates  CommandType = "subscribe_newsletter_updates"
+	CmdInviteNewsletterAdmin       CommandType = "invite_newsletter_admin"
+	CmdListNewsletterAdmins        CommandType = "list_newsletter_admins"
+	CmdRevokeNewsletterAdmin       CommandType = "revoke_newsletter_admin"
+	CmdTransferNewsletterOwnership CommandType = "transfer_newsletter_ownership"
+	CmdDeleteNewsletter            CommandType = "delete_newsletter"
 )
 
 // Worker represents a worker instance
@@ -171,6 +226,7 @@ type Worker struct {
 	groupService      GroupServiceInterface
 	messageService    MessageServiceInterface
 	newsletterService NewsletterServiceInterface
+	rateLimiter       *RateLimiter
 	config            *WorkerConfig
 	mu                sync.RWMutex
 	wg                sync.WaitGroup
@@ -298,28 +354,34 @@ type CommunityServiceInterface interface {
 	GetCommunityInviteLink(userID, communityJID string) (string, error)
 	RevokeCommunityInviteLink(userID, communityJID string) (string, error)
 	JoinCommunityWithLink(userID, link string) (interface{}, error)
+	GetCommunityMembers(userID, communityJID string) (interface{}, error)
+	GetCommunityAnnouncementGroup(userID, communityJID string) (string, error)
 }
 
 // GroupServiceInterface defines group operations interface
 type GroupServiceInterface interface {
 	CreateGroup(userID, name string, participants []string) (interface{}, error)
-	GetGroupInfo(userID, groupJID string) (interface{}, error)
-	GetJoinedGroups(userID string) (interface{}, error)
-	AddGroupParticipants(userID, groupJID string, participants []string) error
-	RemoveGroupParticipants(userID, groupJID string, participants []string) error
-	PromoteGroupParticipants(userID, groupJID string, participants []string) error
-	DemoteGroupParticipants(userID, groupJID string, participants []string) error
+	GetGroupInfo(userID, groupJID string, fresh bool) (interface{}, error)
+	GetJoinedGroups(userID string, fresh bool) (interface{}, error)
+	AddGroupParticipants(userID, groupJID string, participants []string, sendInvite bool, inviteCaption string) (interface{}, error)
+	RemoveGroupParticipants(userID, groupJID string, participants []string) (interface{}, error)
+	PromoteGroupParticipants(userID, groupJID string, participants []string) (interface{}, error)
+	DemoteGroupParticipants(userID, groupJID string, participants []string) (interface{}, error)
 	UpdateGroupName(userID, groupJID, newName string) error
 	UpdateGroupTopic(userID, groupJID, newTopic string) error
 	UpdateGroupPictureFromURL(userID, groupJID, imageURL string) (string, error)
 	LeaveGroup(userID, groupJID string) error
 	JoinGroupWithLink(userID, link string) (interface{}, error)
+	GetGroupInviteInfo(userID, link string) (interface{}, error)
 	GetGroupInviteLink(userID, groupJID string) (string, error)
 	RevokeGroupInviteLink(userID, groupJID string) (string, error)
 	SetGroupLocked(userID, groupJID string, locked bool) error
 	SetGroupAnnounce(userID, groupJID string, announce bool) error
 	SetGroupJoinApprovalMode(userID, groupJID string, mode string) error
 	SetGroupMemberAddMode(userID, groupJID string, mode string) error
+	SetGroupDisappearingTimer(userID, groupJID, timer string) error
+	GetGroupJoinRequests(userID, groupJID string) (interface{}, error)
+	UpdateGroupJoinRequests(userID, groupJID string, participants []string, action string) (interface{}, error)
 }
 
 // MessageServiceInterface defines messaging operations interface
@@ -328,7 +390,36 @@ type MessageServiceInterface interface {
 	SendMedia(userID, to, mediaURL, mediaType, caption string) (string, error)
 	SendButtons(userID, to, text, footer string, buttons []ButtonData) (string, error)
 	SendList(userID, to, text, footer, buttonText string, sections []Section) (string, error)
+	SendAlbum(userID, to string, items []AlbumItem) (interface{}, error)
 	CheckNumberExistsOnWhatsApp(userID, number string) (bool, error)
+	SetChatDisappearingTimer(userID, to, timer string) error
+	SetDefaultDisappearingTimer(userID, timer string) error
+	ArchiveChat(userID, chat string, archive bool) error
+	PinChat(userID, chat string, pin bool) error
+	MuteChat(userID, chat string, mute bool, duration time.Duration) error
+	MarkChatUnread(userID, chat string, unread bool) error
+	ClearChat(userID, chat string) error
+	DeleteChat(userID, chat string) error
+	GetOwnProfile(userID string) (interface{}, error)
+	SetProfilePicture(userID, imageURL string) (string, error)
+	SetPushName(userID, name string) error
+	SetAbout(userID, about string) error
+	GetContactProfile(userID, contact string, fresh bool) (interface{}, error)
+	GetPrivacySettings(userID string) (interface{}, error)
+	UpdatePrivacySettings(userID string, update PrivacySettingsPayload) (interface{}, error)
+	GetBlocklist(userID string) (interface{}, error)
+	BlockContact(userID, contact string) (interface{}, error)
+	UnblockContact(userID, contact string) (interface{}, error)
+	CreateLabel(userID, name string, color int32) (interface{}, error)
+	EditLabel(userID, labelID, name string, color int32) (interface{}, error)
+	DeleteLabel(userID, labelID string) error
+	LabelChat(userID, chat, labelID string, labeled bool) error
+	LabelMessage(userID, chat, messageID, labelID string, labeled bool) error
+	PostTextStatus(userID, text, backgroundColor, font string, audience []string) (string, error)
+	PostMediaStatus(userID, mediaURL, mediaType, caption string, audience []string) (string, error)
+	PublishNewsletterPost(userID string, post NewsletterPostPayload) (interface{}, error)
+	EditNewsletterPost(userID, newsletterJID, messageID, text string) (interface{}, error)
+	DeleteNewsletterPost(userID, newsletterJID, messageID string) error
 }
 
 // NewsletterServiceInterface defines newsletter operations interface
@@ -344,4 +435,12 @@ type NewsletterServiceInterface interface {
 	UpdateNewsletterPictureFromURL(userID, jid, imageURL string) (string, error)
 	UpdateNewsletterName(userID, jid, name string) error
 	UpdateNewsletterDescription(userID, jid, description string) error
+	GetNewsletterPosts(userID, jid string, count, before int) (interface{}, error)
+	GetNewsletterPostUpdates(userID, jid string, count int, since time.Time, after int) (interface{}, error)
+	SubscribeNewsletterLiveUpdates(userID, jid string) (interface{}, error)
+	InviteNewsletterAdmin(userID, jid, user, caption string) (interface{}, error)
+	ListNewsletterAdmins(userID, jid string) (interface{}, error)
+	RevokeNewsletterAdmin(userID, jid, user string) error
+	TransferNewsletterOwnership(userID, jid, user string) error
+	DeleteNewsletter(userID, jid string) error
 }
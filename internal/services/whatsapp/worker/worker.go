@@ -191,6 +191,38 @@ func (w *Worker) processTask(task Task) {
 		w.mu.Unlock()
 	}()
 
+	var response CommandResponse
+
+	// Aplicar o limite de envio da sessão antes de comandos de mensagem
+	if err := w.paceOutbound(task); err != nil {
+		response = CommandResponse{CommandID: task.ID, Error: err}
+	} else {
+		response = w.executeTask(task)
+		w.chargeOutbound(response)
+	}
+
+	// Atualizar métricas
+	w.mu.Lock()
+	if response.Error != nil {
+		w.metrics.TasksFailed++
+		w.metrics.ErrorCount++
+	} else {
+		w.metrics.TasksSuccessful++
+	}
+	w.mu.Unlock()
+
+	// Enviar resposta se solicitada
+	if task.Response != nil {
+		select {
+		case task.Response <- response:
+		case <-time.After(5 * time.Second):
+			logger.Warn("Timeout ao enviar resposta", "worker_id", w.ID, "task_id", task.ID)
+		}
+	}
+}
+
+// executeTask executa o comando da tarefa no serviço correspondente
+func (w *Worker) executeTask(task Task) CommandResponse {
 	var response CommandResponse
 	response.CommandID = task.ID
 
@@ -203,6 +235,8 @@ func (w *Worker) processTask(task Task) {
 		response = w.handleSendButtons(task.Payload.(SendButtonsPayload))
 	case CmdSendList:
 		response = w.handleSendList(task.Payload.(SendListPayload))
+	case CmdSendAlbum:
+		response = w.handleSendAlbum(task.Payload.(SendAlbumPayload))
 	case CmdCheckNumber:
 		response = w.handleCheckNumber(task.Payload.(CheckNumberPayload))
 	case CmdConnect:
@@ -216,6 +250,68 @@ func (w *Worker) processTask(task Task) {
 	case CmdLogout:
 		response = w.handleLogout(context.Background())
 
+		// Chat commands
+	case CmdSetChatDisappearingTimer:
+		response = w.handleSetChatDisappearingTimer(task.Payload.(SetChatDisappearingTimerPayload))
+	case CmdSetDefaultDisappearingTimer:
+		response = w.handleSetDefaultDisappearingTimer(task.Payload.(SetDefaultDisappearingTimerPayload))
+	case CmdArchiveChat:
+		response = w.handleArchiveChat(task.Payload.(ArchiveChatPayload))
+	case CmdPinChat:
+		response = w.handlePinChat(task.Payload.(PinChatPayload))
+	case CmdMuteChat:
+		response = w.handleMuteChat(task.Payload.(MuteChatPayload))
+	case CmdMarkChatUnread:
+		response = w.handleMarkChatUnread(task.Payload.(MarkChatUnreadPayload))
+	case CmdClearChat:
+		response = w.handleClearChat(task.Payload.(ChatPayload))
+	case CmdDeleteChat:
+		response = w.handleDeleteChat(task.Payload.(ChatPayload))
+
+		// Profile commands
+	case CmdGetOwnProfile:
+		response = w.handleGetOwnProfile()
+	case CmdSetProfilePicture:
+		response = w.handleSetProfilePicture(task.Payload.(SetProfilePicturePayload))
+	case CmdSetPushName:
+		response = w.handleSetPushName(task.Payload.(SetPushNamePayload))
+	case CmdSetProfileAbout:
+		response = w.handleSetProfileAbout(task.Payload.(SetProfileAboutPayload))
+	case CmdGetContactProfile:
+		response = w.handleGetContactProfile(task.Payload.(ContactProfilePayload))
+
+		// Privacy commands
+	case CmdGetPrivacySettings:
+		response = w.handleGetPrivacySettings()
+	case CmdUpdatePrivacySettings:
+		response = w.handleUpdatePrivacySettings(task.Payload.(PrivacySettingsPayload))
+
+		// Blocklist commands
+	case CmdGetBlocklist:
+		response = w.handleGetBlocklist()
+	case CmdBlockContact:
+		response = w.handleBlockContact(task.Payload.(BlocklistContactPayload))
+	case CmdUnblockContact:
+		response = w.handleUnblockContact(task.Payload.(BlocklistContactPayload))
+
+		// Label commands
+	case CmdCreateLabel:
+		response = w.handleCreateLabel(task.Payload.(LabelPayload))
+	case CmdEditLabel:
+		response = w.handleEditLabel(task.Payload.(LabelPayload))
+	case CmdDeleteLabel:
+		response = w.handleDeleteLabel(task.Payload.(LabelPayload))
+	case CmdLabelChat:
+		response = w.handleLabelChat(task.Payload.(LabelChatPayload))
+	case CmdLabelMessage:
+		response = w.handleLabelMessage(task.Payload.(LabelMessagePayload))
+
+		// Status commands
+	case CmdPostTextStatus:
+		response = w.handlePostTextStatus(task.Payload.(TextStatusPayload))
+	case CmdPostMediaStatus:
+		response = w.handlePostMediaStatus(task.Payload.(MediaStatusPayload))
+
 		// Community commands
 	case CmdCreateCommunity:
 		response = w.handleCreateCommunity(task.Payload.(CreateCommunityPayload))
@@ -245,6 +341,10 @@ func (w *Worker) processTask(task Task) {
 		response = w.handleGetCommunityLinkedGroups(task.Payload.(GetCommunityLinkedGroupsPayload))
 	case CmdJoinCommunityWithLink:
 		response = w.handleJoinCommunityWithLink(task.Payload.(JoinCommunityWithLinkPayload))
+	case CmdGetCommunityMembers:
+		response = w.handleGetCommunityMembers(task.Payload.(CommunityMembersPayload))
+	case CmdSendCommunityAnnouncement:
+		response = w.handleSendCommunityAnnouncement(task.Payload.(SendCommunityAnnouncementPayload))
 
 		// Group commands
 	case CmdCreateGroup:
@@ -252,7 +352,9 @@ func (w *Worker) processTask(task Task) {
 	case CmdGetGroupInfo:
 		response = w.handleGetGroupInfo(task.Payload.(GroupInfoPayload))
 	case CmdGetJoinedGroups:
-		response = w.handleGetJoinedGroups()
+		// Chamadas antigas enviam a tarefa sem payload
+		payload, _ := task.Payload.(JoinedGroupsPayload)
+		response = w.handleGetJoinedGroups(payload)
 	case CmdAddGroupParticipants:
 		response = w.handleAddGroupParticipants(task.Payload.(GroupParticipantsPayload))
 	case CmdRemoveGroupParticipants:
@@ -271,6 +373,8 @@ func (w *Worker) processTask(task Task) {
 		response = w.handleLeaveGroup(task.Payload.(LeaveGroupPayload))
 	case CmdJoinGroupWithLink:
 		response = w.handleJoinGroupWithLink(task.Payload.(JoinGroupWithLinkPayload))
+	case CmdGetGroupInviteInfo:
+		response = w.handleGetGroupInviteInfo(task.Payload.(GroupInviteInfoPayload))
 	case CmdGetGroupInviteLink:
 		response = w.handleGetGroupInviteLink(task.Payload.(GroupInviteLinkPayload))
 	case CmdRevokeGroupInviteLink:
@@ -283,6 +387,12 @@ func (w *Worker) processTask(task Task) {
 		response = w.handleSetGroupJoinApprovalMode(task.Payload.(SetGroupJoinApprovalModePayload))
 	case CmdSetGroupMemberAddMode:
 		response = w.handleSetGroupMemberAddMode(task.Payload.(SetGroupMemberAddModePayload))
+	case CmdSetGroupDisappearingTimer:
+		response = w.handleSetGroupDisappearingTimer(task.Payload.(SetGroupDisappearingTimerPayload))
+	case CmdGetGroupJoinRequests:
+		response = w.handleGetGroupJoinRequests(task.Payload.(GroupJoinRequestsPayload))
+	case CmdUpdateGroupJoinRequests:
+		response = w.handleUpdateGroupJoinRequests(task.Payload.(UpdateGroupJoinRequestsPayload))
 
 		// Newsletter commands
 	case CmdCreateChannel:
@@ -307,6 +417,28 @@ func (w *Worker) processTask(task Task) {
 		response = w.handleUpdateNewsletterName(task.Payload.(UpdateNewsletterNamePayload))
 	case CmdUpdateNewsletterDescription:
 		response = w.handleUpdateNewsletterDescription(task.Payload.(UpdateNewsletterDescriptionPayload))
+	case CmdPublishNewsletterPost:
+		response = w.handlePublishNewsletterPost(task.Payload.(NewsletterPostPayload))
+	case CmdEditNewsletterPost:
+		response = w.handleEditNewsletterPost(task.Payload.(EditNewsletterPostPayload))
+	case CmdDeleteNewsletterPost:
+		response = w.handleDeleteNewsletterPost(task.Payload.(DeleteNewsletterPostPayload))
+	case CmdGetNewsletterPosts:
+		response = w.handleGetNewsletterPosts(task.Payload.(NewsletterPostsPayload))
+	case CmdGetNewsletterPostUpdates:
+		response = w.handleGetNewsletterPostUpdates(task.Payload.(NewsletterPostUpdatesPayload))
+	case CmdSubscribeNewsletterUpdates:
+		response = w.handleSubscribeNewsletterUpdates(task.Payload.(ChannelJIDPayload))
+	case CmdInviteNewsletterAdmin:
+		response = w.handleInviteNewsletterAdmin(task.Payload.(NewsletterAdminPayload))
+	case CmdListNewsletterAdmins:
+		response = w.handleListNewsletterAdmins(task.Payload.(ChannelJIDPayload))
+	case CmdRevokeNewsletterAdmin:
+		response = w.handleRevokeNewsletterAdmin(task.Payload.(NewsletterAdminPayload))
+	case CmdTransferNewsletterOwnership:
+		response = w.handleTransferNewsletterOwnership(task.Payload.(NewsletterAdminPayload))
+	case CmdDeleteNewsletter:
+		response = w.handleDeleteNewsletter(task.Payload.(ChannelJIDPayload))
 
 	default:
 		response = CommandResponse{
@@ -315,24 +447,7 @@ func (w *Worker) processTask(task Task) {
 		}
 	}
 
-	// Atualizar métricas
-	w.mu.Lock()
-	if response.Error != nil {
-		w.metrics.TasksFailed++
-		w.metrics.ErrorCount++
-	} else {
-		w.metrics.TasksSuccessful++
-	}
-	w.mu.Unlock()
-
-	// Enviar resposta se solicitada
-	if task.Response != nil {
-		select {
-		case task.Response <- response:
-		case <-time.After(5 * time.Second):
-			logger.Warn("Timeout ao enviar resposta", "worker_id", w.ID, "task_id", task.ID)
-		}
-	}
+	return response
 }
 
 // processEvent processa um evento
@@ -379,6 +494,14 @@ func (w *Worker) handleSendList(payload SendListPayload) CommandResponse {
 	return CommandResponse{Data: msgID}
 }
 
+func (w *Worker) handleSendAlbum(payload SendAlbumPayload) CommandResponse {
+	result, err := w.messageService.SendAlbum(w.UserID, payload.To, payload.Items)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao enviar álbum: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
 func (w *Worker) handleCheckNumber(payload CheckNumberPayload) CommandResponse {
 	exists, err := w.messageService.CheckNumberExistsOnWhatsApp(w.UserID, payload.Number)
 	if err != nil {
@@ -387,6 +510,206 @@ func (w *Worker) handleCheckNumber(payload CheckNumberPayload) CommandResponse {
 	return CommandResponse{Data: exists}
 }
 
+func (w *Worker) handleSetChatDisappearingTimer(payload SetChatDisappearingTimerPayload) CommandResponse {
+	err := w.messageService.SetChatDisappearingTimer(w.UserID, payload.To, payload.Timer)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar mensagens temporárias da conversa: %w", err)}
+	}
+	return CommandResponse{Data: "mensagens temporárias da conversa alteradas"}
+}
+
+func (w *Worker) handleSetDefaultDisappearingTimer(payload SetDefaultDisappearingTimerPayload) CommandResponse {
+	err := w.messageService.SetDefaultDisappearingTimer(w.UserID, payload.Timer)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar mensagens temporárias padrão: %w", err)}
+	}
+	return CommandResponse{Data: "mensagens temporárias padrão alteradas"}
+}
+
+func (w *Worker) handleArchiveChat(payload ArchiveChatPayload) CommandResponse {
+	err := w.messageService.ArchiveChat(w.UserID, payload.Chat, payload.Archive)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao arquivar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa arquivada"}
+}
+
+func (w *Worker) handlePinChat(payload PinChatPayload) CommandResponse {
+	err := w.messageService.PinChat(w.UserID, payload.Chat, payload.Pin)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao fixar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa fixada"}
+}
+
+func (w *Worker) handleMuteChat(payload MuteChatPayload) CommandResponse {
+	err := w.messageService.MuteChat(w.UserID, payload.Chat, payload.Mute, payload.Duration)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao silenciar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa silenciada"}
+}
+
+func (w *Worker) handleMarkChatUnread(payload MarkChatUnreadPayload) CommandResponse {
+	err := w.messageService.MarkChatUnread(w.UserID, payload.Chat, payload.Unread)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao marcar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa marcada"}
+}
+
+func (w *Worker) handleClearChat(payload ChatPayload) CommandResponse {
+	err := w.messageService.ClearChat(w.UserID, payload.Chat)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao limpar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa limpa"}
+}
+
+func (w *Worker) handleDeleteChat(payload ChatPayload) CommandResponse {
+	err := w.messageService.DeleteChat(w.UserID, payload.Chat)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao apagar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa apagada"}
+}
+
+func (w *Worker) handleGetOwnProfile() CommandResponse {
+	result, err := w.messageService.GetOwnProfile(w.UserID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter perfil: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleSetProfilePicture(payload SetProfilePicturePayload) CommandResponse {
+	pictureID, err := w.messageService.SetProfilePicture(w.UserID, payload.ImageURL)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar foto de perfil: %w", err)}
+	}
+	return CommandResponse{Data: map[string]string{"picture_id": pictureID}}
+}
+
+func (w *Worker) handleSetPushName(payload SetPushNamePayload) CommandResponse {
+	err := w.messageService.SetPushName(w.UserID, payload.Name)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar nome do perfil: %w", err)}
+	}
+	return CommandResponse{Data: "nome do perfil alterado"}
+}
+
+func (w *Worker) handleSetProfileAbout(payload SetProfileAboutPayload) CommandResponse {
+	err := w.messageService.SetAbout(w.UserID, payload.About)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar recado do perfil: %w", err)}
+	}
+	return CommandResponse{Data: "recado do perfil alterado"}
+}
+
+func (w *Worker) handleGetContactProfile(payload ContactProfilePayload) CommandResponse {
+	result, err := w.messageService.GetContactProfile(w.UserID, payload.Contact, payload.Fresh)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter perfil do contato: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleGetPrivacySettings() CommandResponse {
+	result, err := w.messageService.GetPrivacySettings(w.UserID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter configurações de privacidade: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleUpdatePrivacySettings(payload PrivacySettingsPayload) CommandResponse {
+	result, err := w.messageService.UpdatePrivacySettings(w.UserID, payload)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar configurações de privacidade: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleGetBlocklist() CommandResponse {
+	result, err := w.messageService.GetBlocklist(w.UserID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter lista de bloqueados: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleBlockContact(payload BlocklistContactPayload) CommandResponse {
+	result, err := w.messageService.BlockContact(w.UserID, payload.Contact)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao bloquear contato: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleUnblockContact(payload BlocklistContactPayload) CommandResponse {
+	result, err := w.messageService.UnblockContact(w.UserID, payload.Contact)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao desbloquear contato: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleCreateLabel(payload LabelPayload) CommandResponse {
+	result, err := w.messageService.CreateLabel(w.UserID, payload.Name, payload.Color)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao criar etiqueta: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleEditLabel(payload LabelPayload) CommandResponse {
+	result, err := w.messageService.EditLabel(w.UserID, payload.LabelID, payload.Name, payload.Color)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao editar etiqueta: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleDeleteLabel(payload LabelPayload) CommandResponse {
+	err := w.messageService.DeleteLabel(w.UserID, payload.LabelID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao apagar etiqueta: %w", err)}
+	}
+	return CommandResponse{Data: "etiqueta apagada"}
+}
+
+func (w *Worker) handleLabelChat(payload LabelChatPayload) CommandResponse {
+	err := w.messageService.LabelChat(w.UserID, payload.Chat, payload.LabelID, payload.Labeled)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao etiquetar conversa: %w", err)}
+	}
+	return CommandResponse{Data: "conversa etiquetada"}
+}
+
+func (w *Worker) handleLabelMessage(payload LabelMessagePayload) CommandResponse {
+	err := w.messageService.LabelMessage(w.UserID, payload.Chat, payload.MessageID, payload.LabelID, payload.Labeled)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao etiquetar mensagem: %w", err)}
+	}
+	return CommandResponse{Data: "mensagem etiquetada"}
+}
+
+func (w *Worker) handlePostTextStatus(payload TextStatusPayload) CommandResponse {
+	messageID, err := w.messageService.PostTextStatus(w.UserID, payload.Text, payload.BackgroundColor, payload.Font, payload.Audience)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao publicar status: %w", err)}
+	}
+	return CommandResponse{Data: messageID}
+}
+
+func (w *Worker) handlePostMediaStatus(payload MediaStatusPayload) CommandResponse {
+	messageID, err := w.messageService.PostMediaStatus(w.UserID, payload.MediaURL, payload.MediaType, payload.Caption, payload.Audience)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao publicar status: %w", err)}
+	}
+	return CommandResponse{Data: messageID}
+}
+
 func (w *Worker) handleConnect() CommandResponse {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -552,6 +875,37 @@ func (w *Worker) handleJoinCommunityWithLink(payload JoinCommunityWithLinkPayloa
 	return CommandResponse{Data: result}
 }
 
+func (w *Worker) handleGetCommunityMembers(payload CommunityMembersPayload) CommandResponse {
+	result, err := w.communityService.GetCommunityMembers(w.UserID, payload.CommunityJID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter membros da comunidade: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+// handleSendCommunityAnnouncement envia a mensagem ao grupo de avisos da comunidade
+func (w *Worker) handleSendCommunityAnnouncement(payload SendCommunityAnnouncementPayload) CommandResponse {
+	groupJID, err := w.communityService.GetCommunityAnnouncementGroup(w.UserID, payload.CommunityJID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter grupo de avisos da comunidade: %w", err)}
+	}
+
+	var msgID string
+	if payload.MediaURL != "" {
+		msgID, err = w.messageService.SendMedia(w.UserID, groupJID, payload.MediaURL, payload.MediaType, payload.Caption)
+	} else {
+		msgID, err = w.messageService.SendText(w.UserID, groupJID, payload.Message)
+	}
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao enviar aviso à comunidade: %w", err)}
+	}
+
+	return CommandResponse{Data: map[string]string{
+		"message_id":             msgID,
+		"announcement_group_jid": groupJID,
+	}}
+}
+
 // Group command handlers - now using groupService directly like communities
 func (w *Worker) handleCreateGroup(payload CreateGroupPayload) CommandResponse {
 	result, err := w.groupService.CreateGroup(w.UserID, payload.Name, payload.Participants)
@@ -562,15 +916,15 @@ func (w *Worker) handleCreateGroup(payload CreateGroupPayload) CommandResponse {
 }
 
 func (w *Worker) handleGetGroupInfo(payload GroupInfoPayload) CommandResponse {
-	result, err := w.groupService.GetGroupInfo(w.UserID, payload.GroupJID)
+	result, err := w.groupService.GetGroupInfo(w.UserID, payload.GroupJID, payload.Fresh)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao obter informações do grupo: %w", err)}
 	}
 	return CommandResponse{Data: result}
 }
 
-func (w *Worker) handleGetJoinedGroups() CommandResponse {
-	result, err := w.groupService.GetJoinedGroups(w.UserID)
+func (w *Worker) handleGetJoinedGroups(payload JoinedGroupsPayload) CommandResponse {
+	result, err := w.groupService.GetJoinedGroups(w.UserID, payload.Fresh)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao obter grupos do usuário: %w", err)}
 	}
@@ -578,35 +932,35 @@ func (w *Worker) handleGetJoinedGroups() CommandResponse {
 }
 
 func (w *Worker) handleAddGroupParticipants(payload GroupParticipantsPayload) CommandResponse {
-	err := w.groupService.AddGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
+	result, err := w.groupService.AddGroupParticipants(w.UserID, payload.GroupJID, payload.Participants, payload.SendInvite, payload.InviteCaption)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao adicionar participantes ao grupo: %w", err)}
 	}
-	return CommandResponse{Data: "participantes adicionados ao grupo"}
+	return CommandResponse{Data: result}
 }
 
 func (w *Worker) handleRemoveGroupParticipants(payload GroupParticipantsPayload) CommandResponse {
-	err := w.groupService.RemoveGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
+	result, err := w.groupService.RemoveGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao remover participantes do grupo: %w", err)}
 	}
-	return CommandResponse{Data: "participantes removidos do grupo"}
+	return CommandResponse{Data: result}
 }
 
 func (w *Worker) handlePromoteGroupParticipants(payload GroupParticipantsPayload) CommandResponse {
-	err := w.groupService.PromoteGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
+	result, err := w.groupService.PromoteGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao promover participantes do grupo: %w", err)}
 	}
-	return CommandResponse{Data: "participantes promovidos no grupo"}
+	return CommandResponse{Data: result}
 }
 
 func (w *Worker) handleDemoteGroupParticipants(payload GroupParticipantsPayload) CommandResponse {
-	err := w.groupService.DemoteGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
+	result, err := w.groupService.DemoteGroupParticipants(w.UserID, payload.GroupJID, payload.Participants)
 	if err != nil {
 		return CommandResponse{Error: fmt.Errorf("falha ao rebaixar participantes do grupo: %w", err)}
 	}
-	return CommandResponse{Data: "participantes rebaixados no grupo"}
+	return CommandResponse{Data: result}
 }
 
 func (w *Worker) handleUpdateGroupName(payload UpdateGroupNamePayload) CommandResponse {
@@ -652,6 +1006,14 @@ func (w *Worker) handleJoinGroupWithLink(payload JoinGroupWithLinkPayload) Comma
 	return CommandResponse{Data: result}
 }
 
+func (w *Worker) handleGetGroupInviteInfo(payload GroupInviteInfoPayload) CommandResponse {
+	result, err := w.groupService.GetGroupInviteInfo(w.UserID, payload.Link)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter informações do convite: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
 func (w *Worker) handleGetGroupInviteLink(payload GroupInviteLinkPayload) CommandResponse {
 	link, err := w.groupService.GetGroupInviteLink(w.UserID, payload.GroupJID)
 	if err != nil {
@@ -701,6 +1063,30 @@ func (w *Worker) handleSetGroupMemberAddMode(payload SetGroupMemberAddModePayloa
 	return CommandResponse{Data: "modo de adição de membros do grupo alterado"}
 }
 
+func (w *Worker) handleSetGroupDisappearingTimer(payload SetGroupDisappearingTimerPayload) CommandResponse {
+	err := w.groupService.SetGroupDisappearingTimer(w.UserID, payload.GroupJID, payload.Timer)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao alterar mensagens temporárias do grupo: %w", err)}
+	}
+	return CommandResponse{Data: "mensagens temporárias do grupo alteradas"}
+}
+
+func (w *Worker) handleGetGroupJoinRequests(payload GroupJoinRequestsPayload) CommandResponse {
+	requests, err := w.groupService.GetGroupJoinRequests(w.UserID, payload.GroupJID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter solicitações de entrada do grupo: %w", err)}
+	}
+	return CommandResponse{Data: requests}
+}
+
+func (w *Worker) handleUpdateGroupJoinRequests(payload UpdateGroupJoinRequestsPayload) CommandResponse {
+	result, err := w.groupService.UpdateGroupJoinRequests(w.UserID, payload.GroupJID, payload.Participants, payload.Action)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao atualizar solicitações de entrada do grupo: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
 // Newsletter command handlers - now using newsletterService directly like communities and groups
 func (w *Worker) handleCreateChannel(payload CreateChannelPayload) CommandResponse {
 	result, err := w.newsletterService.CreateChannel(w.UserID, payload.Name, payload.Description, payload.PictureURL)
@@ -792,3 +1178,91 @@ func (w *Worker) handleUpdateNewsletterDescription(payload UpdateNewsletterDescr
 	}
 	return CommandResponse{Data: "descrição da newsletter atualizada com sucesso"}
 }
+
+func (w *Worker) handlePublishNewsletterPost(payload NewsletterPostPayload) CommandResponse {
+	result, err := w.messageService.PublishNewsletterPost(w.UserID, payload)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao publicar na newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleEditNewsletterPost(payload EditNewsletterPostPayload) CommandResponse {
+	result, err := w.messageService.EditNewsletterPost(w.UserID, payload.NewsletterJID, payload.MessageID, payload.Text)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao editar publicação da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleDeleteNewsletterPost(payload DeleteNewsletterPostPayload) CommandResponse {
+	err := w.messageService.DeleteNewsletterPost(w.UserID, payload.NewsletterJID, payload.MessageID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao apagar publicação da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: "publicação da newsletter apagada"}
+}
+
+func (w *Worker) handleGetNewsletterPosts(payload NewsletterPostsPayload) CommandResponse {
+	result, err := w.newsletterService.GetNewsletterPosts(w.UserID, payload.JID, payload.Count, payload.Before)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter publicações da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleGetNewsletterPostUpdates(payload NewsletterPostUpdatesPayload) CommandResponse {
+	result, err := w.newsletterService.GetNewsletterPostUpdates(w.UserID, payload.JID, payload.Count, payload.Since, payload.After)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao obter atualizações da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleSubscribeNewsletterUpdates(payload ChannelJIDPayload) CommandResponse {
+	result, err := w.newsletterService.SubscribeNewsletterLiveUpdates(w.UserID, payload.JID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao inscrever nas atualizações da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleInviteNewsletterAdmin(payload NewsletterAdminPayload) CommandResponse {
+	result, err := w.newsletterService.InviteNewsletterAdmin(w.UserID, payload.JID, payload.User, payload.Caption)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao convidar administrador da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleListNewsletterAdmins(payload ChannelJIDPayload) CommandResponse {
+	result, err := w.newsletterService.ListNewsletterAdmins(w.UserID, payload.JID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao listar administradores da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: result}
+}
+
+func (w *Worker) handleRevokeNewsletterAdmin(payload NewsletterAdminPayload) CommandResponse {
+	err := w.newsletterService.RevokeNewsletterAdmin(w.UserID, payload.JID, payload.User)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao remover administrador da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: "administrador da newsletter removido"}
+}
+
+func (w *Worker) handleTransferNewsletterOwnership(payload NewsletterAdminPayload) CommandResponse {
+	err := w.newsletterService.TransferNewsletterOwnership(w.UserID, payload.JID, payload.User)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao transferir propriedade da newsletter: %w", err)}
+	}
+	return CommandResponse{Data: "propriedade da newsletter transferida"}
+}
+
+func (w *Worker) handleDeleteNewsletter(payload ChannelJIDPayload) CommandResponse {
+	err := w.newsletterService.DeleteNewsletter(w.UserID, payload.JID)
+	if err != nil {
+		return CommandResponse{Error: fmt.Errorf("falha ao apagar newsletter: %w", err)}
+	}
+	return CommandResponse{Data: "newsletter apagada"}
+}
@@ -0,0 +1,374 @@
+// internal/storage/campaign_storage.go
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Campaign represents a persisted broadcast campaign
+type Campaign struct {
+	ID            string     `json:"id"`
+	UserID        string     `json:"user_id"`
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	MessageType   string     `json:"message_type"`
+	Message       string     `json:"message"`
+	MediaURL      string     `json:"media_url,omitempty"`
+	MediaType     string     `json:"media_type,omitempty"`
+	RatePerMinute int        `json:"rate_per_minute"`
+	JitterSeconds int        `json:"jitter_seconds"`
+	QuietStart    string     `json:"quiet_start,omitempty"`
+	QuietEnd      string     `json:"quiet_end,omitempty"`
+	Timezone      string     `json:"timezone,omitempty"`
+	Total         int        `json:"total"`
+	Sent          int        `json:"sent"`
+	Failed        int        `json:"failed"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
+
+// CampaignRecipient represents a single recipient of a campaign and its result
+type CampaignRecipient struct {
+	CampaignID string            `json:"campaign_id"`
+	Position   int               `json:"position"`
+	Recipient  string            `json:"recipient"`
+	Variables  map[string]string `json:"variables,omitempty"`
+	Status     string            `json:"status"`
+	MessageID  string            `json:"message_id,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	SentAt     *time.Time        `json:"sent_at,omitempty"`
+}
+
+// initCampaignTables creates the tables used by broadcast campaigns
+func (s *SQLStore) initCampaignTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS campaigns (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			status TEXT NOT NULL,
+			message_type TEXT NOT NULL,
+			message TEXT,
+			media_url TEXT,
+			media_type TEXT,
+			rate_per_minute INTEGER NOT NULL DEFAULT 20,
+			jitter_seconds INTEGER NOT NULL DEFAULT 0,
+			quiet_start TEXT,
+			quiet_end TEXT,
+			timezone TEXT,
+			total INTEGER NOT NULL DEFAULT 0,
+			sent INTEGER NOT NULL DEFAULT 0,
+			failed INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			started_at TIMESTAMP,
+			completed_at TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create campaigns table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS campaign_recipients (
+			campaign_id TEXT NOT NULL,
+			position INTEGER NOT NULL,
+			recipient TEXT NOT NULL,
+			variables TEXT,
+			status TEXT NOT NULL DEFAULT 'pending',
+			message_id TEXT,
+			error TEXT,
+			sent_at TIMESTAMP,
+			PRIMARY KEY (campaign_id, position)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create campaign_recipients table: %w", err)
+	}
+
+	return nil
+}
+
+// CreateCampaign persists a new campaign together with its recipients
+func (s *SQLStore) CreateCampaign(campaign *Campaign, recipients []CampaignRecipient) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	campaign.CreatedAt = now
+	campaign.UpdatedAt = now
+	campaign.Total = len(recipients)
+
+	_, err = tx.Exec(`
+		INSERT INTO campaigns (id, user_id, name, status, message_type, message, media_url, media_type,
+			rate_per_minute, jitter_seconds, quiet_start, quiet_end, timezone, total, sent, failed, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, 0, ?, ?)
+	`, campaign.ID, campaign.UserID, campaign.Name, campaign.Status, campaign.MessageType, campaign.Message,
+		campaign.MediaURL, campaign.MediaType, campaign.RatePerMinute, campaign.JitterSeconds,
+		campaign.QuietStart, campaign.QuietEnd, campaign.Timezone, campaign.Total, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save campaign: %w", err)
+	}
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO campaign_recipients (campaign_id, position, recipient, variables, status)
+		VALUES (?, ?, ?, ?, 'pending')
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare recipient insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for i, r := range recipients {
+		vars, err := json.Marshal(r.Variables)
+		if err != nil {
+			return fmt.Errorf("failed to encode recipient variables: %w", err)
+		}
+		if _, err := stmt.Exec(campaign.ID, i, r.Recipient, string(vars)); err != nil {
+			return fmt.Errorf("failed to save recipient %s: %w", r.Recipient, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit campaign: %w", err)
+	}
+
+	return nil
+}
+
+// GetCampaign returns a campaign owned by userID
+func (s *SQLStore) GetCampaign(userID, campaignID string) (*Campaign, error) {
+	row := s.db.QueryRow(`
+		SELECT id, user_id, name, status, message_type, message, media_url, media_type,
+			rate_per_minute, jitter_seconds, quiet_start, quiet_end, timezone, total, sent, failed,
+			created_at, updated_at, started_at, completed_at
+		FROM campaigns
+		WHERE id = ? AND user_id = ?
+	`, campaignID, userID)
+
+	campaign, err := scanCampaign(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("campaign not found: %s", campaignID)
+		}
+		return nil, fmt.Errorf("failed to query campaign: %w", err)
+	}
+
+	return campaign, nil
+}
+
+// ListCampaigns returns all campaigns owned by userID, newest first
+func (s *SQLStore) ListCampaigns(userID string) ([]*Campaign, error) {
+	return s.queryCampaigns(`
+		SELECT id, user_id, name, status, message_type, message, media_url, media_type,
+			rate_per_minute, jitter_seconds, quiet_start, quiet_end, timezone, total, sent, failed,
+			created_at, updated_at, started_at, completed_at
+		FROM campaigns
+		WHERE user_id = ?
+		ORDER BY created_at DESC
+	`, userID)
+}
+
+// ListCampaignsByStatus returns all campaigns with the given status across every user
+func (s *SQLStore) ListCampaignsByStatus(status string) ([]*Campaign, error) {
+	return s.queryCampaigns(`
+		SELECT id, user_id, name, status, message_type, message, media_url, media_type,
+			rate_per_minute, jitter_seconds, quiet_start, quiet_end, timezone, total, sent, failed,
+			created_at, updated_at, started_at, completed_at
+		FROM campaigns
+		WHERE status = ?
+	`, status)
+}
+
+// UpdateCampaignStatus changes the status of a campaign and stamps start/completion times
+func (s *SQLStore) UpdateCampaignStatus(campaignID, status string) error {
+	now := time.Now()
+	_, err := s.db.Exec(`
+		UPDATE campaigns SET
+			status = ?,
+			updated_at = ?,
+			started_at = CASE WHEN ? = 'running' AND started_at IS NULL THEN ? ELSE started_at END,
+			completed_at = CASE WHEN ? IN ('completed', 'cancelled') THEN ? ELSE completed_at END
+		WHERE id = ?
+	`, status, now, status, now, status, now, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to update campaign status: %w", err)
+	}
+
+	return nil
+}
+
+// NextPendingRecipient returns the first recipient of a campaign that has not been processed yet
+func (s *SQLStore) NextPendingRecipient(campaignID string) (*CampaignRecipient, error) {
+	var r CampaignRecipient
+	var vars sql.NullString
+	err := s.db.QueryRow(`
+		SELECT campaign_id, position, recipient, variables, status
+		FROM campaign_recipients
+		WHERE campaign_id = ? AND status = 'pending'
+		ORDER BY position
+		LIMIT 1
+	`, campaignID).Scan(&r.CampaignID, &r.Position, &r.Recipient, &vars, &r.Status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query pending recipient: %w", err)
+	}
+
+	if vars.Valid && vars.String != "" {
+		if err := json.Unmarshal([]byte(vars.String), &r.Variables); err != nil {
+			return nil, fmt.Errorf("failed to decode recipient variables: %w", err)
+		}
+	}
+
+	return &r, nil
+}
+
+// SaveRecipientResult records the outcome of sending to a recipient and updates campaign counters
+func (s *SQLStore) SaveRecipientResult(campaignID string, position int, status, messageID, errMsg string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	_, err = tx.Exec(`
+		UPDATE campaign_recipients SET status = ?, message_id = ?, error = ?, sent_at = ?
+		WHERE campaign_id = ? AND position = ?
+	`, status, messageID, errMsg, now, campaignID, position)
+	if err != nil {
+		return fmt.Errorf("failed to save recipient result: %w", err)
+	}
+
+	counter := "failed"
+	if status == "sent" {
+		counter = "sent"
+	}
+	_, err = tx.Exec(fmt.Sprintf(`UPDATE campaigns SET %s = %s + 1, updated_at = ? WHERE id = ?`, counter, counter), now, campaignID)
+	if err != nil {
+		return fmt.Errorf("failed to update campaign counters: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit recipient result: %w", err)
+	}
+
+	return nil
+}
+
+// ListCampaignRecipients returns per-recipient results of a campaign, optionally filtered by status
+func (s *SQLStore) ListCampaignRecipients(campaignID, status string, limit, offset int) ([]CampaignRecipient, error) {
+	query := `
+		SELECT campaign_id, position, recipient, variables, status, message_id, error, sent_at
+		FROM campaign_recipients
+		WHERE campaign_id = ?`
+	args := []interface{}{campaignID}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY position LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var recipients []CampaignRecipient
+	for rows.Next() {
+		var r CampaignRecipient
+		var vars, messageID, errMsg sql.NullString
+		var sentAt sql.NullTime
+
+		if err := rows.Scan(&r.CampaignID, &r.Position, &r.Recipient, &vars, &r.Status, &messageID, &errMsg, &sentAt); err != nil {
+			return nil, fmt.Errorf("failed to read recipient: %w", err)
+		}
+
+		if vars.Valid && vars.String != "" {
+			json.Unmarshal([]byte(vars.String), &r.Variables)
+		}
+		r.MessageID = messageID.String
+		r.Error = errMsg.String
+		if sentAt.Valid {
+			r.SentAt = &sentAt.Time
+		}
+
+		recipients = append(recipients, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// queryCampaigns runs a campaign query and scans every row
+func (s *SQLStore) queryCampaigns(query string, args ...interface{}) ([]*Campaign, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	var campaigns []*Campaign
+	for rows.Next() {
+		campaign, err := scanCampaign(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read campaign: %w", err)
+		}
+		campaigns = append(campaigns, campaign)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCampaign reads a campaign from a row
+func scanCampaign(row rowScanner) (*Campaign, error) {
+	var c Campaign
+	var message, mediaURL, mediaType, quietStart, quietEnd, timezone sql.NullString
+	var startedAt, completedAt sql.NullTime
+
+	err := row.Scan(&c.ID, &c.UserID, &c.Name, &c.Status, &c.MessageType, &message, &mediaURL, &mediaType,
+		&c.RatePerMinute, &c.JitterSeconds, &quietStart, &quietEnd, &timezone, &c.Total, &c.Sent, &c.Failed,
+		&c.CreatedAt, &c.UpdatedAt, &startedAt, &completedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Message = message.String
+	c.MediaURL = mediaURL.String
+	c.MediaType = mediaType.String
+	c.QuietStart = quietStart.String
+	c.QuietEnd = quietEnd.String
+	c.Timezone = timezone.String
+	if startedAt.Valid {
+		c.StartedAt = &startedAt.Time
+	}
+	if completedAt.Valid {
+		c.CompletedAt = &completedAt.Time
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,273 @@
+// internal/storage/chat_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ChatMutedForever is the mute expiry stored for chats muted without an end
+var ChatMutedForever = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// execer is implemented by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// ListChats returns the chats of a session, pinned first and then by most recent message
+func (s *SQLStore) ListChats(userID string, limit, offset int) ([]Chat, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := s.db.Query(`
+		SELECT jid, name, unread_count, marked_unread, archived, pinned, muted_until, last_message_at,
+			last_message_id, last_message_sender, last_message_from_me, last_message_type, last_message_text, updated_at
+		FROM chats
+		WHERE user_id = ?
+		ORDER BY pinned DESC, last_message_at DESC, jid
+		LIMIT ? OFFSET ?
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chats: %w", err)
+	}
+	defer rows.Close()
+
+	var chats []Chat
+	for rows.Next() {
+		chat, err := scanChat(rows)
+		if err != nil {
+			return nil, err
+		}
+		chats = append(chats, *chat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return chats, nil
+}
+
+// CountChats returns the number of chats of a session
+func (s *SQLStore) CountChats(userID string) (int, error) {
+	var count int
+	err := s.db.QueryRow(`
+		SELECT COUNT(*) FROM chats WHERE user_id = ?
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count chats: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetChat returns a chat of a session, or nil when it is not stored
+func (s *SQLStore) GetChat(userID, jid string) (*Chat, error) {
+	row := s.db.QueryRow(`
+		SELECT jid, name, unread_count, marked_unread, archived, pinned, muted_until, last_message_at,
+			last_message_id, last_message_sender, last_message_from_me, last_message_type, last_message_text, updated_at
+		FROM chats
+		WHERE user_id = ? AND jid = ?
+	`, userID, jid)
+
+	chat, err := scanChat(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return chat, nil
+}
+
+// RecordChatMessage makes a live message the last message of its chat.
+// Incoming messages increase the unread count; messages sent by the account mark the chat as read.
+func (s *SQLStore) RecordChatMessage(userID string, msg StoredMessage) error {
+	now := time.Now()
+
+	unreadDelta := 1
+	if msg.FromMe {
+		unreadDelta = 0
+	}
+
+	_, err := s.db.Exec(`
+		INSERT INTO chats (user_id, jid, unread_count, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			unread_count = CASE WHEN ? THEN 0 ELSE chats.unread_count + excluded.unread_count END,
+			marked_unread = CASE WHEN ? THEN 0 ELSE chats.marked_unread END,
+			updated_at = excluded.updated_at
+	`, userID, msg.ChatJID, unreadDelta, now, msg.FromMe, msg.FromMe)
+	if err != nil {
+		return fmt.Errorf("failed to record chat message: %w", err)
+	}
+
+	preview := &ChatMessagePreview{
+		ID:        msg.ID,
+		SenderJID: msg.SenderJID,
+		FromMe:    msg.FromMe,
+		Type:      msg.Type,
+		Text:      msg.Text,
+	}
+
+	return updateChatLastMessage(s.db, userID, msg.ChatJID, msg.Timestamp, preview, now)
+}
+
+// SetChatArchived stores whether a chat is archived. Archiving also unpins the chat, as WhatsApp does.
+func (s *SQLStore) SetChatArchived(userID, jid string, archived bool) error {
+	return s.upsertChatState(userID, jid, `
+		archived = excluded.archived,
+		pinned = CASE WHEN excluded.archived THEN 0 ELSE chats.pinned END
+	`, "archived", archived)
+}
+
+// SetChatPinned stores whether a chat is pinned
+func (s *SQLStore) SetChatPinned(userID, jid string, pinned bool) error {
+	return s.upsertChatState(userID, jid, "pinned = excluded.pinned", "pinned", pinned)
+}
+
+// SetChatMutedUntil stores until when a chat is muted. Nil unmutes the chat.
+func (s *SQLStore) SetChatMutedUntil(userID, jid string, mutedUntil *time.Time) error {
+	return s.upsertChatState(userID, jid, "muted_until = excluded.muted_until", "muted_until", mutedUntil)
+}
+
+// SetChatRead stores whether a chat is read. Reading a chat clears its unread count.
+func (s *SQLStore) SetChatRead(userID, jid string, read bool) error {
+	return s.upsertChatState(userID, jid, `
+		marked_unread = excluded.marked_unread,
+		unread_count = CASE WHEN excluded.marked_unread THEN chats.unread_count ELSE 0 END
+	`, "marked_unread", !read)
+}
+
+// ClearChat removes the stored messages of a chat and its last message, keeping the chat itself
+func (s *SQLStore) ClearChat(userID, jid string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM messages WHERE user_id = ? AND chat_jid = ?`, userID, jid); err != nil {
+		return fmt.Errorf("failed to clear chat messages: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE chats SET
+			unread_count = 0,
+			marked_unread = 0,
+			last_message_id = '',
+			last_message_sender = '',
+			last_message_from_me = 0,
+			last_message_type = '',
+			last_message_text = '',
+			updated_at = ?
+		WHERE user_id = ? AND jid = ?
+	`, time.Now(), userID, jid)
+	if err != nil {
+		return fmt.Errorf("failed to clear chat: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit chat clear: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteChat removes a chat and its stored messages
+func (s *SQLStore) DeleteChat(userID, jid string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM messages WHERE user_id = ? AND chat_jid = ?`, userID, jid); err != nil {
+		return fmt.Errorf("failed to delete chat messages: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM chats WHERE user_id = ? AND jid = ?`, userID, jid); err != nil {
+		return fmt.Errorf("failed to delete chat: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit chat deletion: %w", err)
+	}
+
+	return nil
+}
+
+// upsertChatState stores a single state column of a chat, creating the chat when it is not stored yet
+func (s *SQLStore) upsertChatState(userID, jid, assignments, column string, value interface{}) error {
+	_, err := s.db.Exec(fmt.Sprintf(`
+		INSERT INTO chats (user_id, jid, %s, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			%s,
+			updated_at = excluded.updated_at
+	`, column, assignments), userID, jid, value, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update chat %s: %w", column, err)
+	}
+
+	return nil
+}
+
+// updateChatLastMessage sets the last message of a chat unless a newer one is already stored.
+// Without a preview only the time is updated, and a message at the same time is kept.
+func updateChatLastMessage(db execer, userID, jid string, at time.Time, preview *ChatMessagePreview, now time.Time) error {
+	if preview == nil {
+		_, err := db.Exec(`
+			UPDATE chats SET last_message_at = ?, last_message_id = '', last_message_sender = '',
+				last_message_from_me = 0, last_message_type = '', last_message_text = '', updated_at = ?
+			WHERE user_id = ? AND jid = ? AND (last_message_at IS NULL OR last_message_at < ?)
+		`, at, now, userID, jid, at)
+		if err != nil {
+			return fmt.Errorf("failed to update last message of chat %s: %w", jid, err)
+		}
+		return nil
+	}
+
+	_, err := db.Exec(`
+		UPDATE chats SET last_message_at = ?, last_message_id = ?, last_message_sender = ?,
+			last_message_from_me = ?, last_message_type = ?, last_message_text = ?, updated_at = ?
+		WHERE user_id = ? AND jid = ? AND (last_message_at IS NULL OR last_message_at <= ?)
+	`, at, preview.ID, preview.SenderJID, preview.FromMe, preview.Type, preview.Text, now, userID, jid, at)
+	if err != nil {
+		return fmt.Errorf("failed to update last message of chat %s: %w", jid, err)
+	}
+
+	return nil
+}
+
+// scanChat reads a chat selected with the columns used by ListChats and GetChat
+func scanChat(row rowScanner) (*Chat, error) {
+	var chat Chat
+	var mutedUntil, lastMessageAt sql.NullTime
+	var preview ChatMessagePreview
+
+	err := row.Scan(&chat.JID, &chat.Name, &chat.UnreadCount, &chat.MarkedUnread, &chat.Archived, &chat.Pinned,
+		&mutedUntil, &lastMessageAt, &preview.ID, &preview.SenderJID, &preview.FromMe, &preview.Type, &preview.Text,
+		&chat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chat: %w", err)
+	}
+
+	if mutedUntil.Valid {
+		chat.MutedUntil = &mutedUntil.Time
+	}
+	if lastMessageAt.Valid {
+		chat.LastMessageAt = &lastMessageAt.Time
+	}
+	if preview.ID != "" {
+		chat.LastMessage = &preview
+	}
+
+	return &chat, nil
+}
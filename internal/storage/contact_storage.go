@@ -0,0 +1,232 @@
+// internal/storage/contact_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// ListContacts returns the contacts of a session ordered by name.
+// A non-empty search matches names and phone numbers.
+func (s *SQLStore) ListContacts(userID, search string, limit, offset int) ([]Contact, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+
+	where, args := contactSearchFilter(userID, search)
+	rows, err := s.db.Query(`
+		SELECT jid, phone, lid, full_name, first_name, push_name, business_name, on_whatsapp, updated_at
+		FROM contacts
+		WHERE `+where+`
+		ORDER BY COALESCE(NULLIF(full_name, ''), NULLIF(push_name, ''), NULLIF(business_name, ''), phone, jid), jid
+		LIMIT ? OFFSET ?
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contacts: %w", err)
+	}
+	defer rows.Close()
+
+	var contacts []Contact
+	for rows.Next() {
+		contact, err := scanContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, *contact)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return contacts, nil
+}
+
+// CountContacts returns how many contacts of a session match the search
+func (s *SQLStore) CountContacts(userID, search string) (int, error) {
+	where, args := contactSearchFilter(userID, search)
+
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM contacts WHERE `+where, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetContact returns a contact of a session by JID or LID, or nil when it is not stored
+func (s *SQLStore) GetContact(userID, jid string) (*Contact, error) {
+	row := s.db.QueryRow(`
+		SELECT jid, phone, lid, full_name, first_name, push_name, business_name, on_whatsapp, updated_at
+		FROM contacts
+		WHERE user_id = ? AND (jid = ? OR (lid != '' AND lid = ?))
+		ORDER BY jid = ? DESC
+		LIMIT 1
+	`, userID, jid, jid, jid)
+
+	contact, err := scanContact(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// SaveContactName stores the address book name of a contact synced from app state
+func (s *SQLStore) SaveContactName(userID, jid, lid, fullName, firstName string) error {
+	_, err := s.db.Exec(`
+		INSERT INTO contacts (user_id, jid, phone, lid, full_name, first_name, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			lid = CASE WHEN excluded.lid != '' THEN excluded.lid ELSE contacts.lid END,
+			full_name = excluded.full_name,
+			first_name = excluded.first_name,
+			updated_at = excluded.updated_at
+	`, userID, jid, contactPhone(jid), lid, fullName, firstName, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save contact name %s: %w", jid, err)
+	}
+
+	return nil
+}
+
+// SaveContactPushName stores the name a contact set for itself
+func (s *SQLStore) SaveContactPushName(userID, jid, pushName string) error {
+	return saveContactPushName(s.db, userID, jid, pushName, time.Now())
+}
+
+// SaveContactBusinessName stores the verified business name of a contact
+func (s *SQLStore) SaveContactBusinessName(userID, jid, businessName string) error {
+	_, err := s.db.Exec(`
+		INSERT INTO contacts (user_id, jid, phone, business_name, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			business_name = excluded.business_name,
+			updated_at = excluded.updated_at
+	`, userID, jid, contactPhone(jid), businessName, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save contact business name %s: %w", jid, err)
+	}
+
+	return nil
+}
+
+// ImportContacts stores address book entries resolved on WhatsApp.
+// The imported name only fills contacts without a name synced from the phone.
+func (s *SQLStore) ImportContacts(userID string, contacts []Contact) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO contacts (user_id, jid, phone, full_name, business_name, on_whatsapp, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			full_name = CASE WHEN contacts.full_name = '' THEN excluded.full_name ELSE contacts.full_name END,
+			business_name = CASE WHEN excluded.business_name != '' THEN excluded.business_name ELSE contacts.business_name END,
+			on_whatsapp = excluded.on_whatsapp,
+			updated_at = excluded.updated_at
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare contact import: %w", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for _, contact := range contacts {
+		phone := contact.Phone
+		if phone == "" {
+			phone = contactPhone(contact.JID)
+		}
+		if _, err := stmt.Exec(userID, contact.JID, phone, contact.FullName, contact.BusinessName, contact.OnWhatsApp, now); err != nil {
+			return fmt.Errorf("failed to import contact %s: %w", contact.JID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit contact import: %w", err)
+	}
+
+	return nil
+}
+
+// saveContactPushName stores the push name of a contact, keeping the previous one when it is empty
+func saveContactPushName(db execer, userID, jid, pushName string, now time.Time) error {
+	if pushName == "" {
+		return nil
+	}
+
+	_, err := db.Exec(`
+		INSERT INTO contacts (user_id, jid, phone, push_name, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			push_name = excluded.push_name,
+			updated_at = excluded.updated_at
+	`, userID, jid, contactPhone(jid), pushName, now)
+	if err != nil {
+		return fmt.Errorf("failed to save contact %s: %w", jid, err)
+	}
+
+	return nil
+}
+
+// contactSearchFilter builds the WHERE clause matching contacts by name or phone number
+func contactSearchFilter(userID, search string) (string, []interface{}) {
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return "user_id = ?", []interface{}{userID}
+	}
+
+	pattern := "%" + search + "%"
+	args := []interface{}{userID, pattern, pattern, pattern, pattern}
+
+	// Numbers are matched by their digits, so "+55 (11) 9..." finds stored phones
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, search)
+	phoneFilter := ""
+	if digits != "" {
+		phoneFilter = " OR phone LIKE ?"
+		args = append(args, "%"+digits+"%")
+	}
+
+	return `user_id = ? AND (full_name LIKE ? OR first_name LIKE ? OR push_name LIKE ? OR business_name LIKE ?` + phoneFilter + `)`, args
+}
+
+// contactPhone returns the phone number of a phone-based JID
+func contactPhone(jid string) string {
+	parsed, err := types.ParseJID(jid)
+	if err != nil || parsed.Server != types.DefaultUserServer {
+		return ""
+	}
+	return parsed.User
+}
+
+// scanContact reads a contact selected with the columns used by ListContacts and GetContact
+func scanContact(row rowScanner) (*Contact, error) {
+	var contact Contact
+
+	err := row.Scan(&contact.JID, &contact.Phone, &contact.LID, &contact.FullName, &contact.FirstName,
+		&contact.PushName, &contact.BusinessName, &contact.OnWhatsApp, &contact.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contact: %w", err)
+	}
+
+	return &contact, nil
+}
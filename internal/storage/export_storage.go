@@ -0,0 +1,163 @@
+// internal/storage/export_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ExportJob represents a background export whose file is downloaded once it completes
+type ExportJob struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Kind        string     `json:"kind"`
+	Format      string     `json:"format"`
+	Status      string     `json:"status"`
+	FilePath    string     `json:"-"`
+	Groups      int        `json:"groups"`
+	Rows        int        `json:"rows"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// initExportTables creates the table tracking background exports
+func (s *SQLStore) initExportTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS export_jobs (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			format TEXT NOT NULL,
+			status TEXT NOT NULL,
+			file_path TEXT NOT NULL,
+			groups_count INTEGER NOT NULL DEFAULT 0,
+			rows_count INTEGER NOT NULL DEFAULT 0,
+			error TEXT,
+			created_at TIMESTAMP NOT NULL,
+			completed_at TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create export_jobs table: %w", err)
+	}
+
+	return nil
+}
+
+// CreateExportJob persists a new export job
+func (s *SQLStore) CreateExportJob(job *ExportJob) error {
+	_, err := s.db.Exec(`
+		INSERT INTO export_jobs (id, user_id, kind, format, status, file_path, groups_count, rows_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, job.ID, job.UserID, job.Kind, job.Format, job.Status, job.FilePath, job.Groups, job.Rows, job.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create export job: %w", err)
+	}
+
+	return nil
+}
+
+// GetExportJob returns an export job owned by userID
+func (s *SQLStore) GetExportJob(userID, jobID string) (*ExportJob, error) {
+	row := s.db.QueryRow(`
+		SELECT id, user_id, kind, format, status, file_path, groups_count, rows_count, error, created_at, completed_at
+		FROM export_jobs
+		WHERE id = ? AND user_id = ?
+	`, jobID, userID)
+
+	job, err := scanExportJob(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("export job not found: %s", jobID)
+		}
+		return nil, fmt.Errorf("failed to query export job: %w", err)
+	}
+
+	return job, nil
+}
+
+// FinishExportJob records the final status of an export job
+func (s *SQLStore) FinishExportJob(jobID, status string, rows int, errMsg string) error {
+	_, err := s.db.Exec(`
+		UPDATE export_jobs SET status = ?, rows_count = ?, error = ?, completed_at = ?
+		WHERE id = ?
+	`, status, rows, errMsg, time.Now(), jobID)
+	if err != nil {
+		return fmt.Errorf("failed to finish export job: %w", err)
+	}
+
+	return nil
+}
+
+// FailRunningExportJobs marks jobs interrupted by a restart as failed
+func (s *SQLStore) FailRunningExportJobs(errMsg string) (int64, error) {
+	result, err := s.db.Exec(`
+		UPDATE export_jobs SET status = 'failed', error = ?, completed_at = ?
+		WHERE status = 'running'
+	`, errMsg, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to update interrupted export jobs: %w", err)
+	}
+
+	updated, _ := result.RowsAffected()
+	return updated, nil
+}
+
+// ListExportJobsBefore returns every export job created before the given time
+func (s *SQLStore) ListExportJobsBefore(before time.Time) ([]*ExportJob, error) {
+	rows, err := s.db.Query(`
+		SELECT id, user_id, kind, format, status, file_path, groups_count, rows_count, error, created_at, completed_at
+		FROM export_jobs
+		WHERE created_at < ?
+	`, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query export jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*ExportJob
+	for rows.Next() {
+		job, err := scanExportJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read export job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// DeleteExportJob removes an export job record
+func (s *SQLStore) DeleteExportJob(jobID string) error {
+	if _, err := s.db.Exec(`DELETE FROM export_jobs WHERE id = ?`, jobID); err != nil {
+		return fmt.Errorf("failed to delete export job: %w", err)
+	}
+
+	return nil
+}
+
+// scanExportJob reads an export job from a row
+func scanExportJob(row rowScanner) (*ExportJob, error) {
+	var job ExportJob
+	var errMsg sql.NullString
+	var completedAt sql.NullTime
+
+	err := row.Scan(&job.ID, &job.UserID, &job.Kind, &job.Format, &job.Status, &job.FilePath,
+		&job.Groups, &job.Rows, &errMsg, &job.CreatedAt, &completedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Error = errMsg.String
+	if completedAt.Valid {
+		job.CompletedAt = &completedAt.Time
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,209 @@
+// internal/storage/group_cache_storage.go
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// CachedGroup is a group metadata snapshot kept to avoid querying WhatsApp on every read
+type CachedGroup struct {
+	Info      types.GroupInfo `json:"info"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// initGroupCacheTables creates the tables caching group metadata
+func (s *SQLStore) initGroupCacheTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS group_cache (
+			user_id TEXT NOT NULL,
+			group_jid TEXT NOT NULL,
+			info TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, group_jid)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create group_cache table: %w", err)
+	}
+
+	// Marks sessions whose cache holds the complete list of joined groups
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS group_cache_sync (
+			user_id TEXT PRIMARY KEY,
+			synced_at TIMESTAMP NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create group_cache_sync table: %w", err)
+	}
+
+	return nil
+}
+
+// GetCachedGroup returns the cached metadata of a group, or nil if it is not cached
+func (s *SQLStore) GetCachedGroup(userID, groupJID string) (*CachedGroup, error) {
+	var info string
+	var cached CachedGroup
+	err := s.db.QueryRow(`
+		SELECT info, updated_at
+		FROM group_cache
+		WHERE user_id = ? AND group_jid = ?
+	`, userID, groupJID).Scan(&info, &cached.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached group: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(info), &cached.Info); err != nil {
+		return nil, fmt.Errorf("failed to decode cached group %s: %w", groupJID, err)
+	}
+
+	return &cached, nil
+}
+
+// ListCachedGroups returns every cached group of a session and whether the list is complete.
+// The list is complete once SyncCachedGroups has stored a full group listing.
+func (s *SQLStore) ListCachedGroups(userID string) ([]CachedGroup, bool, error) {
+	var syncedAt time.Time
+	err := s.db.QueryRow(`
+		SELECT synced_at FROM group_cache_sync WHERE user_id = ?
+	`, userID).Scan(&syncedAt)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get group cache sync: %w", err)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT group_jid, info, updated_at
+		FROM group_cache
+		WHERE user_id = ?
+		ORDER BY group_jid
+	`, userID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list cached groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []CachedGroup
+	for rows.Next() {
+		var groupJID, info string
+		var cached CachedGroup
+		if err := rows.Scan(&groupJID, &info, &cached.UpdatedAt); err != nil {
+			return nil, false, fmt.Errorf("failed to read cached group: %w", err)
+		}
+		if err := json.Unmarshal([]byte(info), &cached.Info); err != nil {
+			return nil, false, fmt.Errorf("failed to decode cached group %s: %w", groupJID, err)
+		}
+		groups = append(groups, cached)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return groups, true, nil
+}
+
+// SaveCachedGroup stores or refreshes the metadata of a single group
+func (s *SQLStore) SaveCachedGroup(userID string, info *types.GroupInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("failed to encode group %s: %w", info.JID, err)
+	}
+
+	_, err = s.db.Exec(`
+		INSERT INTO group_cache (user_id, group_jid, info, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id, group_jid) DO UPDATE SET
+			info = excluded.info,
+			updated_at = excluded.updated_at
+	`, userID, info.JID.String(), string(data), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save cached group: %w", err)
+	}
+
+	return nil
+}
+
+// SyncCachedGroups replaces the cache of a session with a full group listing.
+// Groups missing from the listing are removed, since the session is no longer in them.
+func (s *SQLStore) SyncCachedGroups(userID string, groups []*types.GroupInfo) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM group_cache WHERE user_id = ?`, userID); err != nil {
+		return fmt.Errorf("failed to clear cached groups: %w", err)
+	}
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO group_cache (user_id, group_jid, info, updated_at)
+		VALUES (?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for _, info := range groups {
+		data, err := json.Marshal(info)
+		if err != nil {
+			return fmt.Errorf("failed to encode group %s: %w", info.JID, err)
+		}
+		if _, err := stmt.Exec(userID, info.JID.String(), string(data), now); err != nil {
+			return fmt.Errorf("failed to save cached group %s: %w", info.JID, err)
+		}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO group_cache_sync (user_id, synced_at)
+		VALUES (?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET synced_at = excluded.synced_at
+	`, userID, now)
+	if err != nil {
+		return fmt.Errorf("failed to save group cache sync: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCachedGroup removes a group the session is no longer part of
+func (s *SQLStore) DeleteCachedGroup(userID, groupJID string) error {
+	_, err := s.db.Exec(`
+		DELETE FROM group_cache
+		WHERE user_id = ? AND group_jid = ?
+	`, userID, groupJID)
+	if err != nil {
+		return fmt.Errorf("failed to delete cached group: %w", err)
+	}
+
+	return nil
+}
+
+// ClearCachedGroups removes every cached group of a session
+func (s *SQLStore) ClearCachedGroups(userID string) error {
+	if _, err := s.db.Exec(`DELETE FROM group_cache WHERE user_id = ?`, userID); err != nil {
+		return fmt.Errorf("failed to clear cached groups: %w", err)
+	}
+	if _, err := s.db.Exec(`DELETE FROM group_cache_sync WHERE user_id = ?`, userID); err != nil {
+		return fmt.Errorf("failed to clear group cache sync: %w", err)
+	}
+
+	return nil
+}
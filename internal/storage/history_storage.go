@@ -0,0 +1,279 @@
+// internal/storage/history_storage.go
+package storage
+
+import (
+	"fmt"
+	"time"
+)
+
+// Chat is a conversation of a session as known from history sync and live events
+type Chat struct {
+	JID           string              `json:"jid"`
+	Name          string              `json:"name,omitempty"`
+	UnreadCount   int                 `json:"unread_count"`
+	MarkedUnread  bool                `json:"marked_unread"`
+	Archived      bool                `json:"archived"`
+	Pinned        bool                `json:"pinned"`
+	MutedUntil    *time.Time          `json:"muted_until,omitempty"`
+	LastMessageAt *time.Time          `json:"last_message_at,omitempty"`
+	LastMessage   *ChatMessagePreview `json:"last_message,omitempty"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// ChatMessagePreview is the last message of a chat
+type ChatMessagePreview struct {
+	ID        string `json:"id"`
+	SenderJID string `json:"sender_jid,omitempty"`
+	FromMe    bool   `json:"from_me"`
+	Type      string `json:"type"`
+	Text      string `json:"text,omitempty"`
+}
+
+// Contact is a contact of a session as known from the address book, app state sync and push names
+type Contact struct {
+	JID          string    `json:"jid"`
+	Phone        string    `json:"phone,omitempty"`
+	LID          string    `json:"lid,omitempty"`
+	FullName     string    `json:"full_name,omitempty"`
+	FirstName    string    `json:"first_name,omitempty"`
+	PushName     string    `json:"push_name,omitempty"`
+	BusinessName string    `json:"business_name,omitempty"`
+	OnWhatsApp   bool      `json:"on_whatsapp"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// StoredMessage is a past message imported from history sync
+type StoredMessage struct {
+	ID        string    `json:"id"`
+	ChatJID   string    `json:"chat_jid"`
+	SenderJID string    `json:"sender_jid"`
+	PushName  string    `json:"push_name,omitempty"`
+	FromMe    bool      `json:"from_me"`
+	Type      string    `json:"type"`
+	Text      string    `json:"text,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// HistoryBatch holds the data parsed from one history sync blob
+type HistoryBatch struct {
+	Chats    []Chat
+	Contacts []Contact
+	Messages []StoredMessage
+}
+
+// initHistoryTables creates the tables holding chats, contacts and messages imported from history sync
+func (s *SQLStore) initHistoryTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS chats (
+			user_id TEXT NOT NULL,
+			jid TEXT NOT NULL,
+			name TEXT NOT NULL DEFAULT '',
+			unread_count INTEGER NOT NULL DEFAULT 0,
+			marked_unread BOOLEAN NOT NULL DEFAULT 0,
+			archived BOOLEAN NOT NULL DEFAULT 0,
+			pinned BOOLEAN NOT NULL DEFAULT 0,
+			muted_until TIMESTAMP,
+			last_message_at TIMESTAMP,
+			last_message_id TEXT NOT NULL DEFAULT '',
+			last_message_sender TEXT NOT NULL DEFAULT '',
+			last_message_from_me BOOLEAN NOT NULL DEFAULT 0,
+			last_message_type TEXT NOT NULL DEFAULT '',
+			last_message_text TEXT NOT NULL DEFAULT '',
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, jid)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create chats table: %w", err)
+	}
+
+	// Chats tables created before chat state and last message previews were stored lack these columns
+	err = s.ensureColumns("chats", []tableColumn{
+		{"marked_unread", "BOOLEAN NOT NULL DEFAULT 0"},
+		{"last_message_id", "TEXT NOT NULL DEFAULT ''"},
+		{"last_message_sender", "TEXT NOT NULL DEFAULT ''"},
+		{"last_message_from_me", "BOOLEAN NOT NULL DEFAULT 0"},
+		{"last_message_type", "TEXT NOT NULL DEFAULT ''"},
+		{"last_message_text", "TEXT NOT NULL DEFAULT ''"},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS contacts (
+			user_id TEXT NOT NULL,
+			jid TEXT NOT NULL,
+			phone TEXT NOT NULL DEFAULT '',
+			lid TEXT NOT NULL DEFAULT '',
+			full_name TEXT NOT NULL DEFAULT '',
+			first_name TEXT NOT NULL DEFAULT '',
+			push_name TEXT NOT NULL DEFAULT '',
+			business_name TEXT NOT NULL DEFAULT '',
+			on_whatsapp BOOLEAN NOT NULL DEFAULT 1,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, jid)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contacts table: %w", err)
+	}
+
+	// Contacts tables created when only push names were stored lack these columns
+	err = s.ensureColumns("contacts", []tableColumn{
+		{"phone", "TEXT NOT NULL DEFAULT ''"},
+		{"lid", "TEXT NOT NULL DEFAULT ''"},
+		{"full_name", "TEXT NOT NULL DEFAULT ''"},
+		{"first_name", "TEXT NOT NULL DEFAULT ''"},
+		{"business_name", "TEXT NOT NULL DEFAULT ''"},
+		{"on_whatsapp", "BOOLEAN NOT NULL DEFAULT 1"},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_contacts_phone
+		ON contacts (user_id, phone)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contacts index: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS messages (
+			user_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			message_id TEXT NOT NULL,
+			sender_jid TEXT NOT NULL DEFAULT '',
+			push_name TEXT NOT NULL DEFAULT '',
+			from_me BOOLEAN NOT NULL DEFAULT 0,
+			type TEXT NOT NULL,
+			text TEXT NOT NULL DEFAULT '',
+			timestamp TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, chat_jid, message_id)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create messages table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_messages_chat_timestamp
+		ON messages (user_id, chat_jid, timestamp)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create messages index: %w", err)
+	}
+
+	return nil
+}
+
+// SaveHistoryBatch stores the chats, contacts and messages of a history sync blob in a single transaction.
+// Blobs may repeat data already stored, so existing rows are refreshed instead of duplicated.
+func (s *SQLStore) SaveHistoryBatch(userID string, batch HistoryBatch) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	chatStmt, err := tx.Prepare(`
+		INSERT INTO chats (user_id, jid, name, unread_count, marked_unread, archived, pinned, muted_until, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			name = CASE WHEN excluded.name != '' THEN excluded.name ELSE chats.name END,
+			unread_count = excluded.unread_count,
+			marked_unread = excluded.marked_unread,
+			archived = excluded.archived,
+			pinned = excluded.pinned,
+			muted_until = excluded.muted_until,
+			updated_at = excluded.updated_at
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare chat insert: %w", err)
+	}
+	defer chatStmt.Close()
+
+	for _, chat := range batch.Chats {
+		if _, err := chatStmt.Exec(userID, chat.JID, chat.Name, chat.UnreadCount, chat.MarkedUnread, chat.Archived, chat.Pinned,
+			chat.MutedUntil, now); err != nil {
+			return fmt.Errorf("failed to save chat %s: %w", chat.JID, err)
+		}
+		if chat.LastMessageAt != nil {
+			if err := updateChatLastMessage(tx, userID, chat.JID, *chat.LastMessageAt, chat.LastMessage, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contact := range batch.Contacts {
+		if err := saveContactPushName(tx, userID, contact.JID, contact.PushName, now); err != nil {
+			return err
+		}
+	}
+
+	messageStmt, err := tx.Prepare(`
+		INSERT INTO messages (user_id, chat_jid, message_id, sender_jid, push_name, from_me, type, text, timestamp)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, chat_jid, message_id) DO NOTHING
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare message insert: %w", err)
+	}
+	defer messageStmt.Close()
+
+	for _, msg := range batch.Messages {
+		if _, err := messageStmt.Exec(userID, msg.ChatJID, msg.ID, msg.SenderJID, msg.PushName, msg.FromMe,
+			msg.Type, msg.Text, msg.Timestamp); err != nil {
+			return fmt.Errorf("failed to save message %s: %w", msg.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit history batch: %w", err)
+	}
+
+	return nil
+}
+
+// ListMessages returns the stored messages of a chat sent before the given time, newest first.
+// A zero before returns the latest messages.
+func (s *SQLStore) ListMessages(userID, chatJID string, before time.Time, limit int) ([]StoredMessage, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+	if before.IsZero() {
+		before = time.Now().Add(time.Hour)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT message_id, chat_jid, sender_jid, push_name, from_me, type, text, timestamp
+		FROM messages
+		WHERE user_id = ? AND chat_jid = ? AND timestamp < ?
+		ORDER BY timestamp DESC, message_id
+		LIMIT ?
+	`, userID, chatJID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []StoredMessage
+	for rows.Next() {
+		var msg StoredMessage
+		if err := rows.Scan(&msg.ID, &msg.ChatJID, &msg.SenderJID, &msg.PushName, &msg.FromMe,
+			&msg.Type, &msg.Text, &msg.Timestamp); err != nil {
+			return nil, fmt.Errorf("failed to read message: %w", err)
+		}
+		messages = append(messages, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,259 @@
+// internal/storage/label_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Label is a WhatsApp Business label of a session
+type Label struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Color        int32     `json:"color"`
+	PredefinedID int32     `json:"predefined_id,omitempty"`
+	ChatCount    int       `json:"chat_count"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// initLabelTables creates the tables holding labels and the chats and messages they are attached to
+func (s *SQLStore) initLabelTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS labels (
+			user_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			name TEXT NOT NULL DEFAULT '',
+			color INTEGER NOT NULL DEFAULT 0,
+			predefined_id INTEGER NOT NULL DEFAULT 0,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, label_id)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create labels table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS label_chats (
+			user_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, label_id, chat_jid)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create label_chats table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS label_messages (
+			user_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			message_id TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, label_id, chat_jid, message_id)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create label_messages table: %w", err)
+	}
+
+	return nil
+}
+
+// ListLabels returns the labels of a session with how many chats each one is attached to
+func (s *SQLStore) ListLabels(userID string) ([]Label, error) {
+	rows, err := s.db.Query(`
+		SELECT l.label_id, l.name, l.color, l.predefined_id, l.updated_at,
+			(SELECT COUNT(*) FROM label_chats lc WHERE lc.user_id = l.user_id AND lc.label_id = l.label_id)
+		FROM labels l
+		WHERE l.user_id = ?
+		ORDER BY CAST(l.label_id AS INTEGER), l.label_id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query labels: %w", err)
+	}
+	defer rows.Close()
+
+	var labels []Label
+	for rows.Next() {
+		label, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, *label)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return labels, nil
+}
+
+// GetLabel returns a label of a session, or nil when it is not stored
+func (s *SQLStore) GetLabel(userID, labelID string) (*Label, error) {
+	row := s.db.QueryRow(`
+		SELECT l.label_id, l.name, l.color, l.predefined_id, l.updated_at,
+			(SELECT COUNT(*) FROM label_chats lc WHERE lc.user_id = l.user_id AND lc.label_id = l.label_id)
+		FROM labels l
+		WHERE l.user_id = ? AND l.label_id = ?
+	`, userID, labelID)
+
+	label, err := scanLabel(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+// NextLabelID returns the ID for a new label. WhatsApp numbers labels sequentially per account.
+func (s *SQLStore) NextLabelID(userID string) (string, error) {
+	var maxID sql.NullInt64
+	err := s.db.QueryRow(`
+		SELECT MAX(CAST(label_id AS INTEGER)) FROM labels WHERE user_id = ?
+	`, userID).Scan(&maxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next label id: %w", err)
+	}
+
+	return strconv.FormatInt(maxID.Int64+1, 10), nil
+}
+
+// SaveLabel creates or updates a label
+func (s *SQLStore) SaveLabel(userID string, label Label) error {
+	_, err := s.db.Exec(`
+		INSERT INTO labels (user_id, label_id, name, color, predefined_id, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, label_id) DO UPDATE SET
+			name = excluded.name,
+			color = excluded.color,
+			predefined_id = excluded.predefined_id,
+			updated_at = excluded.updated_at
+	`, userID, label.ID, label.Name, label.Color, label.PredefinedID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save label %s: %w", label.ID, err)
+	}
+
+	return nil
+}
+
+// DeleteLabel removes a label and detaches it from every chat and message
+func (s *SQLStore) DeleteLabel(userID, labelID string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM label_chats WHERE user_id = ? AND label_id = ?`, userID, labelID); err != nil {
+		return fmt.Errorf("failed to delete label chats: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM label_messages WHERE user_id = ? AND label_id = ?`, userID, labelID); err != nil {
+		return fmt.Errorf("failed to delete label messages: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM labels WHERE user_id = ? AND label_id = ?`, userID, labelID); err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit label deletion: %w", err)
+	}
+
+	return nil
+}
+
+// SetChatLabel attaches a label to a chat or detaches it
+func (s *SQLStore) SetChatLabel(userID, labelID, chatJID string, labeled bool) error {
+	var err error
+	if labeled {
+		_, err = s.db.Exec(`
+			INSERT INTO label_chats (user_id, label_id, chat_jid, updated_at)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT(user_id, label_id, chat_jid) DO UPDATE SET updated_at = excluded.updated_at
+		`, userID, labelID, chatJID, time.Now())
+	} else {
+		_, err = s.db.Exec(`
+			DELETE FROM label_chats WHERE user_id = ? AND label_id = ? AND chat_jid = ?
+		`, userID, labelID, chatJID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update label %s of chat %s: %w", labelID, chatJID, err)
+	}
+
+	return nil
+}
+
+// SetMessageLabel attaches a label to a message or detaches it
+func (s *SQLStore) SetMessageLabel(userID, labelID, chatJID, messageID string, labeled bool) error {
+	var err error
+	if labeled {
+		_, err = s.db.Exec(`
+			INSERT INTO label_messages (user_id, label_id, chat_jid, message_id, updated_at)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT(user_id, label_id, chat_jid, message_id) DO UPDATE SET updated_at = excluded.updated_at
+		`, userID, labelID, chatJID, messageID, time.Now())
+	} else {
+		_, err = s.db.Exec(`
+			DELETE FROM label_messages WHERE user_id = ? AND label_id = ? AND chat_jid = ? AND message_id = ?
+		`, userID, labelID, chatJID, messageID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update label %s of message %s: %w", labelID, messageID, err)
+	}
+
+	return nil
+}
+
+// ListLabelChats returns the JIDs of the chats a label is attached to
+func (s *SQLStore) ListLabelChats(userID, labelID string) ([]string, error) {
+	rows, err := s.db.Query(`
+		SELECT chat_jid FROM label_chats
+		WHERE user_id = ? AND label_id = ?
+		ORDER BY updated_at DESC, chat_jid
+	`, userID, labelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query label chats: %w", err)
+	}
+	defer rows.Close()
+
+	var chats []string
+	for rows.Next() {
+		var chatJID string
+		if err := rows.Scan(&chatJID); err != nil {
+			return nil, fmt.Errorf("failed to read label chat: %w", err)
+		}
+		chats = append(chats, chatJID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return chats, nil
+}
+
+// scanLabel reads a label selected with the columns used by ListLabels and GetLabel
+func scanLabel(row rowScanner) (*Label, error) {
+	var label Label
+
+	err := row.Scan(&label.ID, &label.Name, &label.Color, &label.PredefinedID, &label.UpdatedAt, &label.ChatCount)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read label: %w", err)
+	}
+
+	return &label, nil
+}
@@ -0,0 +1,187 @@
+// internal/storage/number_cache_storage.go
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NumberLookup is a cached IsOnWhatsApp result for a phone number
+type NumberLookup struct {
+	Phone     string    `json:"phone"`
+	JID       string    `json:"jid,omitempty"`
+	Exists    bool      `json:"exists"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// initNumberCacheTables creates the table caching WhatsApp number lookups
+func (s *SQLStore) initNumberCacheTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS number_lookup_cache (
+			user_id TEXT NOT NULL,
+			phone TEXT NOT NULL,
+			jid TEXT NOT NULL DEFAULT '',
+			exists_on_whatsapp BOOLEAN NOT NULL,
+			checked_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, phone)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create number_lookup_cache table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_number_lookup_cache_checked_at
+		ON number_lookup_cache (checked_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create number_lookup_cache index: %w", err)
+	}
+
+	return nil
+}
+
+// GetNumberLookups returns the cached lookups of the given phone numbers, keyed by phone.
+// Numbers without a cached lookup are absent from the map.
+func (s *SQLStore) GetNumberLookups(userID string, phones []string) (map[string]NumberLookup, error) {
+	lookups := make(map[string]NumberLookup, len(phones))
+	if len(phones) == 0 {
+		return lookups, nil
+	}
+
+	// Keep well below SQLite's bound parameter limit
+	const chunkSize = 500
+	for start := 0; start < len(phones); start += chunkSize {
+		end := start + chunkSize
+		if end > len(phones) {
+			end = len(phones)
+		}
+		chunk := phones[start:end]
+
+		args := make([]interface{}, 0, len(chunk)+1)
+		args = append(args, userID)
+		for _, phone := range chunk {
+			args = append(args, phone)
+		}
+
+		rows, err := s.db.Query(`
+			SELECT phone, jid, exists_on_whatsapp, checked_at
+			FROM number_lookup_cache
+			WHERE user_id = ? AND phone IN (?`+strings.Repeat(", ?", len(chunk)-1)+`)
+		`, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query number lookups: %w", err)
+		}
+
+		for rows.Next() {
+			var lookup NumberLookup
+			if err := rows.Scan(&lookup.Phone, &lookup.JID, &lookup.Exists, &lookup.CheckedAt); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to read number lookup: %w", err)
+			}
+			lookups[lookup.Phone] = lookup
+		}
+
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error during result iteration: %w", err)
+		}
+	}
+
+	return lookups, nil
+}
+
+// SaveNumberLookups stores or refreshes lookups in a single transaction
+func (s *SQLStore) SaveNumberLookups(userID string, lookups []NumberLookup) error {
+	if len(lookups) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO number_lookup_cache (user_id, phone, jid, exists_on_whatsapp, checked_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, phone) DO UPDATE SET
+			jid = excluded.jid,
+			exists_on_whatsapp = excluded.exists_on_whatsapp,
+			checked_at = excluded.checked_at
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, lookup := range lookups {
+		checkedAt := lookup.CheckedAt
+		if checkedAt.IsZero() {
+			checkedAt = time.Now()
+		}
+		if _, err := stmt.Exec(userID, lookup.Phone, lookup.JID, lookup.Exists, checkedAt); err != nil {
+			return fmt.Errorf("failed to save number lookup %s: %w", lookup.Phone, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteNumberLookups removes the cached lookups of the given phone numbers
+func (s *SQLStore) DeleteNumberLookups(userID string, phones ...string) error {
+	if len(phones) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(phones)+1)
+	args = append(args, userID)
+	for _, phone := range phones {
+		args = append(args, phone)
+	}
+
+	_, err := s.db.Exec(`
+		DELETE FROM number_lookup_cache
+		WHERE user_id = ? AND phone IN (?`+strings.Repeat(", ?", len(phones)-1)+`)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete number lookups: %w", err)
+	}
+
+	return nil
+}
+
+// ClearNumberLookups removes every cached lookup of a session
+func (s *SQLStore) ClearNumberLookups(userID string) (int64, error) {
+	result, err := s.db.Exec(`
+		DELETE FROM number_lookup_cache
+		WHERE user_id = ?
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clear number lookups: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	return removed, nil
+}
+
+// PurgeNumberLookups removes lookups checked before the given time
+func (s *SQLStore) PurgeNumberLookups(before time.Time) (int64, error) {
+	result, err := s.db.Exec(`
+		DELETE FROM number_lookup_cache
+		WHERE checked_at < ?
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge number lookups: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	return removed, nil
+}
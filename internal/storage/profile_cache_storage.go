@@ -0,0 +1,116 @@
+// internal/storage/profile_cache_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ContactProfile is the cached public profile of a WhatsApp contact
+type ContactProfile struct {
+	JID          string    `json:"jid"`
+	PictureURL   string    `json:"picture_url,omitempty"`
+	PictureID    string    `json:"picture_id,omitempty"`
+	About        string    `json:"about,omitempty"`
+	BusinessName string    `json:"business_name,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+}
+
+// initProfileCacheTables creates the table caching contact profiles
+func (s *SQLStore) initProfileCacheTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS contact_profile_cache (
+			user_id TEXT NOT NULL,
+			jid TEXT NOT NULL,
+			picture_url TEXT NOT NULL DEFAULT '',
+			picture_id TEXT NOT NULL DEFAULT '',
+			about TEXT NOT NULL DEFAULT '',
+			business_name TEXT NOT NULL DEFAULT '',
+			fetched_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, jid)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_profile_cache table: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_contact_profile_cache_fetched_at
+		ON contact_profile_cache (fetched_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_profile_cache index: %w", err)
+	}
+
+	return nil
+}
+
+// GetContactProfile returns the cached profile of a contact, or nil when it is not cached
+func (s *SQLStore) GetContactProfile(userID, jid string) (*ContactProfile, error) {
+	var profile ContactProfile
+	err := s.db.QueryRow(`
+		SELECT jid, picture_url, picture_id, about, business_name, fetched_at
+		FROM contact_profile_cache
+		WHERE user_id = ? AND jid = ?
+	`, userID, jid).Scan(&profile.JID, &profile.PictureURL, &profile.PictureID, &profile.About, &profile.BusinessName, &profile.FetchedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contact profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// SaveContactProfile stores or refreshes the cached profile of a contact
+func (s *SQLStore) SaveContactProfile(userID string, profile ContactProfile) error {
+	fetchedAt := profile.FetchedAt
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now()
+	}
+
+	_, err := s.db.Exec(`
+		INSERT INTO contact_profile_cache (user_id, jid, picture_url, picture_id, about, business_name, fetched_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, jid) DO UPDATE SET
+			picture_url = excluded.picture_url,
+			picture_id = excluded.picture_id,
+			about = excluded.about,
+			business_name = excluded.business_name,
+			fetched_at = excluded.fetched_at
+	`, userID, profile.JID, profile.PictureURL, profile.PictureID, profile.About, profile.BusinessName, fetchedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save contact profile %s: %w", profile.JID, err)
+	}
+
+	return nil
+}
+
+// DeleteContactProfile removes the cached profile of a contact
+func (s *SQLStore) DeleteContactProfile(userID, jid string) error {
+	_, err := s.db.Exec(`
+		DELETE FROM contact_profile_cache
+		WHERE user_id = ? AND jid = ?
+	`, userID, jid)
+	if err != nil {
+		return fmt.Errorf("failed to delete contact profile: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeContactProfiles removes profiles fetched before the given time
+func (s *SQLStore) PurgeContactProfiles(before time.Time) (int64, error) {
+	result, err := s.db.Exec(`
+		DELETE FROM contact_profile_cache
+		WHERE fetched_at < ?
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge contact profiles: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	return removed, nil
+}
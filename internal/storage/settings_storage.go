@@ -0,0 +1,105 @@
+// internal/storage/settings_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"yourproject/pkg/logger"
+)
+
+// initSettingsTables creates the table for per-session settings
+func (s *SQLStore) initSettingsTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS session_settings (
+			user_id TEXT NOT NULL,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, key)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create session_settings table: %w", err)
+	}
+
+	return nil
+}
+
+// SaveSessionSetting stores a setting value (usually JSON) for a session
+func (s *SQLStore) SaveSessionSetting(userID, key, value string) error {
+	_, err := s.db.Exec(`
+		INSERT INTO session_settings (user_id, key, value, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id, key) DO UPDATE SET
+			value = excluded.value,
+			updated_at = excluded.updated_at
+	`, userID, key, value, time.Now())
+
+	if err != nil {
+		return fmt.Errorf("failed to save session setting %s: %w", key, err)
+	}
+
+	logger.Debug("Session setting saved", "user_id", userID, "key", key)
+	return nil
+}
+
+// GetSessionSetting returns a setting value for a session and whether it exists
+func (s *SQLStore) GetSessionSetting(userID, key string) (string, bool, error) {
+	var value string
+	err := s.db.QueryRow(`
+		SELECT value FROM session_settings
+		WHERE user_id = ? AND key = ?
+	`, userID, key).Scan(&value)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to query session setting %s: %w", key, err)
+	}
+
+	return value, true, nil
+}
+
+// GetSessionSettingsByKey returns the value of a setting for every session that has it
+func (s *SQLStore) GetSessionSettingsByKey(key string) (map[string]string, error) {
+	rows, err := s.db.Query(`
+		SELECT user_id, value FROM session_settings
+		WHERE key = ?
+	`, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session settings %s: %w", key, err)
+	}
+	defer rows.Close()
+
+	settings := make(map[string]string)
+	for rows.Next() {
+		var userID, value string
+		if err := rows.Scan(&userID, &value); err != nil {
+			return nil, fmt.Errorf("failed to read session setting: %w", err)
+		}
+		settings[userID] = value
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return settings, nil
+}
+
+// DeleteSessionSetting removes a setting from a session
+func (s *SQLStore) DeleteSessionSetting(userID, key string) error {
+	_, err := s.db.Exec(`
+		DELETE FROM session_settings
+		WHERE user_id = ? AND key = ?
+	`, userID, key)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove session setting %s: %w", key, err)
+	}
+
+	return nil
+}
@@ -88,6 +88,92 @@ func (s *SQLStore) initTables() error {
 		return fmt.Errorf("failed to create user_device_mapping table: %w", err)
 	}
 
+	// Table for per-session settings
+	if err := s.initSettingsTables(); err != nil {
+		return err
+	}
+
+	// Table for the opt-out suppression list
+	if err := s.initSuppressionTables(); err != nil {
+		return err
+	}
+
+	// Tables for broadcast campaigns
+	if err := s.initCampaignTables(); err != nil {
+		return err
+	}
+
+	// Table caching WhatsApp number lookups
+	if err := s.initNumberCacheTables(); err != nil {
+		return err
+	}
+
+	// Tables caching group metadata
+	if err := s.initGroupCacheTables(); err != nil {
+		return err
+	}
+
+	// Table tracking background exports
+	if err := s.initExportTables(); err != nil {
+		return err
+	}
+
+	// Table caching contact profiles
+	if err := s.initProfileCacheTables(); err != nil {
+		return err
+	}
+
+	// Tables holding chats, contacts and messages imported from history sync
+	if err := s.initHistoryTables(); err != nil {
+		return err
+	}
+
+	// Tables holding WhatsApp Business labels and what they are attached to
+	if err := s.initLabelTables(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// tableColumn is a column added to a table after it was first created
+type tableColumn struct {
+	name       string
+	definition string
+}
+
+// ensureColumns adds the columns missing from a table created by an older version of its schema
+func (s *SQLStore) ensureColumns(table string, columns []tableColumn) error {
+	rows, err := s.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var cid, notNull, primaryKey int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return fmt.Errorf("failed to read %s columns: %w", table, err)
+		}
+		existing[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	rows.Close()
+
+	for _, column := range columns {
+		if existing[column.name] {
+			continue
+		}
+		if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column.name, column.definition)); err != nil {
+			return fmt.Errorf("failed to add %s.%s column: %w", table, column.name, err)
+		}
+	}
+
 	return nil
 }
 
@@ -128,6 +214,25 @@ func (s *SQLStore) GetDeviceJIDByUserID(userID string) (string, error) {
 	return deviceJID, nil
 }
 
+// GetUserDeviceMapping returns the full mapping for a userID
+func (s *SQLStore) GetUserDeviceMapping(userID string) (*UserDeviceMapping, error) {
+	var mapping UserDeviceMapping
+	err := s.db.QueryRow(`
+		SELECT user_id, device_jid, created_at, updated_at
+		FROM user_device_mapping
+		WHERE user_id = ?
+	`, userID).Scan(&mapping.UserID, &mapping.DeviceJID, &mapping.CreatedAt, &mapping.UpdatedAt)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("mapping not found for userID: %s", userID)
+		}
+		return nil, fmt.Errorf("failed to query mapping: %w", err)
+	}
+
+	return &mapping, nil
+}
+
 // GetAllUserDeviceMappings returns all userID -> deviceJID mappings
 func (s *SQLStore) GetAllUserDeviceMappings() ([]UserDeviceMapping, error) {
 	rows, err := s.db.Query(`
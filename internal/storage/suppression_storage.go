@@ -0,0 +1,209 @@
+// internal/storage/suppression_storage.go
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"yourproject/pkg/logger"
+)
+
+// SuppressionEntry represents a phone number that opted out of receiving messages from a session
+type SuppressionEntry struct {
+	UserID    string    `json:"-"`
+	Phone     string    `json:"phone"`
+	Reason    string    `json:"reason,omitempty"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// initSuppressionTables creates the table for the per-session suppression list
+func (s *SQLStore) initSuppressionTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS suppression_list (
+			user_id TEXT NOT NULL,
+			phone TEXT NOT NULL,
+			reason TEXT,
+			source TEXT NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, phone)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create suppression_list table: %w", err)
+	}
+
+	return nil
+}
+
+// AddSuppression adds a phone number to the suppression list of a session.
+// Returns false if the number was already suppressed.
+func (s *SQLStore) AddSuppression(entry SuppressionEntry) (bool, error) {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	result, err := s.db.Exec(`
+		INSERT INTO suppression_list (user_id, phone, reason, source, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, phone) DO NOTHING
+	`, entry.UserID, entry.Phone, sql.NullString{String: entry.Reason, Valid: entry.Reason != ""}, entry.Source, entry.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to add suppression: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected > 0 {
+		logger.Debug("Suppression added", "user_id", entry.UserID, "phone", entry.Phone, "source", entry.Source)
+	}
+
+	return affected > 0, nil
+}
+
+// ImportSuppressions adds several phone numbers in a single transaction and returns how many were new
+func (s *SQLStore) ImportSuppressions(entries []SuppressionEntry) (int, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO suppression_list (user_id, phone, reason, source, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id, phone) DO NOTHING
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare suppression insert: %w", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	added := 0
+	for _, entry := range entries {
+		result, err := stmt.Exec(entry.UserID, entry.Phone, sql.NullString{String: entry.Reason, Valid: entry.Reason != ""}, entry.Source, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to import suppression %s: %w", entry.Phone, err)
+		}
+		if affected, _ := result.RowsAffected(); affected > 0 {
+			added++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit suppressions: %w", err)
+	}
+
+	return added, nil
+}
+
+// RemoveSuppression removes a phone number from the suppression list.
+// Returns false if the number was not suppressed.
+func (s *SQLStore) RemoveSuppression(userID, phone string) (bool, error) {
+	result, err := s.db.Exec(`
+		DELETE FROM suppression_list
+		WHERE user_id = ? AND phone = ?
+	`, userID, phone)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove suppression: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	return affected > 0, nil
+}
+
+// IsSuppressed checks whether any of the phone numbers is in the suppression list of a session
+func (s *SQLStore) IsSuppressed(userID string, phones ...string) (bool, error) {
+	for _, phone := range phones {
+		var exists int
+		err := s.db.QueryRow(`
+			SELECT 1 FROM suppression_list
+			WHERE user_id = ? AND phone = ?
+		`, userID, phone).Scan(&exists)
+
+		if err == nil {
+			return true, nil
+		}
+		if err != sql.ErrNoRows {
+			return false, fmt.Errorf("failed to query suppression: %w", err)
+		}
+	}
+
+	return false, nil
+}
+
+// GetSuppression returns a suppression entry or nil if the number is not suppressed
+func (s *SQLStore) GetSuppression(userID, phone string) (*SuppressionEntry, error) {
+	entry := SuppressionEntry{UserID: userID}
+	var reason sql.NullString
+
+	err := s.db.QueryRow(`
+		SELECT phone, reason, source, created_at
+		FROM suppression_list
+		WHERE user_id = ? AND phone = ?
+	`, userID, phone).Scan(&entry.Phone, &reason, &entry.Source, &entry.CreatedAt)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query suppression: %w", err)
+	}
+
+	entry.Reason = reason.String
+	return &entry, nil
+}
+
+// ListSuppressions returns a page of the suppression list of a session, newest first.
+// A limit of zero or less returns every entry.
+func (s *SQLStore) ListSuppressions(userID string, limit, offset int) ([]SuppressionEntry, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := s.db.Query(`
+		SELECT phone, reason, source, created_at
+		FROM suppression_list
+		WHERE user_id = ?
+		ORDER BY created_at DESC, phone
+		LIMIT ? OFFSET ?
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query suppressions: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []SuppressionEntry
+	for rows.Next() {
+		entry := SuppressionEntry{UserID: userID}
+		var reason sql.NullString
+
+		if err := rows.Scan(&entry.Phone, &reason, &entry.Source, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read suppression: %w", err)
+		}
+
+		entry.Reason = reason.String
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during result iteration: %w", err)
+	}
+
+	return entries, nil
+}
+
+// CountSuppressions returns the size of the suppression list of a session
+func (s *SQLStore) CountSuppressions(userID string) (int, error) {
+	var count int
+	err := s.db.QueryRow(`
+		SELECT COUNT(*) FROM suppression_list
+		WHERE user_id = ?
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count suppressions: %w", err)
+	}
+
+	return count, nil
+}